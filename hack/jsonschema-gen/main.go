@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This program writes the JSON Schema of the served KubeOneCluster API
+// versions to the pkg/apis/kubeone/jsonschema directory. It's run from the
+// root of the repository by hack/update-jsonschema.sh.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"k8c.io/kubeone/pkg/apis/kubeone/jsonschema"
+)
+
+const (
+	apisDir   = "pkg/apis/kubeone"
+	schemaDir = "pkg/apis/kubeone/jsonschema"
+)
+
+func main() {
+	for _, version := range jsonschema.Versions {
+		buf, err := jsonschema.Generate(version.APIVersion, version.Object, filepath.Join(apisDir, version.TypesFile))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		if err = os.WriteFile(filepath.Join(schemaDir, jsonschema.FileName(version.APIVersion)), buf, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
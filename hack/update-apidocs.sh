@@ -49,3 +49,4 @@ EOF
 
 genVersionedDoc "v1beta2"
 genVersionedDoc "v1beta3"
+genVersionedDoc "v1beta4"
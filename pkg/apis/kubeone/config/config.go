@@ -26,6 +26,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	kubeonescheme "k8c.io/kubeone/pkg/apis/kubeone/scheme"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
 	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
+	kubeonev1beta4 "k8c.io/kubeone/pkg/apis/kubeone/v1beta4"
 	kubeonevalidation "k8c.io/kubeone/pkg/apis/kubeone/validation"
 	"k8c.io/kubeone/pkg/fail"
 	terraformv1beta2 "k8c.io/kubeone/pkg/terraform/v1beta2"
@@ -56,31 +58,35 @@ var (
 	// AllowedAPIs contains APIs which are allowed to be used
 	AllowedAPIs = map[string]string{
 		kubeonev1beta2.SchemeGroupVersion.String(): "",
-		// kubeonev1beta3.SchemeGroupVersion.String(): "",
+		kubeonev1beta3.SchemeGroupVersion.String(): "",
+		kubeonev1beta4.SchemeGroupVersion.String(): "",
 	}
 
-	// DeprecatedAPIs contains APIs which are deprecated
+	// DeprecatedAPIs contains APIs which are deprecated, mapped to the API
+	// version "kubeone config migrate" migrates them to
 	DeprecatedAPIs = map[string]string{
-		// kubeonev1beta2.SchemeGroupVersion.String(): "",
+		kubeonev1beta3.SchemeGroupVersion.String(): kubeonev1beta4.SchemeGroupVersion.String(),
 	}
 )
 
 // LoadKubeOneCluster returns the internal representation of the KubeOneCluster object
-// parsed from the versioned KubeOneCluster manifest, Terraform output and credentials file
-func LoadKubeOneCluster(clusterCfgPath, tfOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
-	if len(clusterCfgPath) == 0 {
+// parsed from the versioned KubeOneCluster manifests, Terraform output and credentials file.
+// The manifests are merged in order, see ReadManifests, and the relative paths left are
+// resolved from the directory of the last one.
+func LoadKubeOneCluster(clusterCfgPaths []string, tfOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
+	if len(clusterCfgPaths) == 0 || slices.Contains(clusterCfgPaths, "") {
 		return nil, fail.Runtime(fmt.Errorf("is not provided"), "cluster configuration path")
 	}
 
-	cfgAbsPath, err := filepath.Abs(clusterCfgPath)
+	cfgAbsPath, err := filepath.Abs(clusterCfgPaths[len(clusterCfgPaths)-1])
 	if err != nil {
 		return nil, err
 	}
 	cfgBaseDir := filepath.Dir(cfgAbsPath)
 
-	cluster, err := os.ReadFile(clusterCfgPath)
+	cluster, _, err := ReadManifests(clusterCfgPaths)
 	if err != nil {
-		return nil, fail.Runtime(err, "reading cluster configuration")
+		return nil, err
 	}
 
 	tfOutput, err := TFOutput(tfOutputPath)
@@ -144,8 +150,11 @@ func BytesToKubeOneCluster(cluster, tfOutput []byte, credentialsFilePath string,
 	if _, ok := AllowedAPIs[typeMeta.APIVersion]; !ok {
 		return nil, fail.ConfigValidation(fmt.Errorf("provided apiVersion %q is not supported", typeMeta.APIVersion))
 	}
-	if _, ok := DeprecatedAPIs[typeMeta.APIVersion]; ok {
-		logger.Warningf(`The provided APIVersion %q is deprecated. Please use "kubeone config migrate" command to migrate to the latest version.`, typeMeta.APIVersion)
+	if migrateTo, ok := DeprecatedAPIs[typeMeta.APIVersion]; ok {
+		if migrateTo == "" {
+			migrateTo = "the latest version"
+		}
+		logger.Warningf(`The provided APIVersion %q is deprecated. Please use "kubeone config migrate" command to migrate to %s.`, typeMeta.APIVersion, migrateTo)
 	}
 
 	var (
@@ -165,16 +174,26 @@ func BytesToKubeOneCluster(cluster, tfOutput []byte, credentialsFilePath string,
 		if err != nil {
 			return nil, err
 		}
-	// case kubeonev1beta3.SchemeGroupVersion.String():
-	// 	v1beta3Cluster := kubeonev1beta3.NewKubeOneCluster()
-	// 	if err := runtime.DecodeInto(kubeonescheme.Codecs.UniversalDecoder(), cluster, v1beta3Cluster); err != nil {
-	// 		return nil, fail.Config(err, fmt.Sprintf("decoding %s", v1beta3Cluster.GroupVersionKind()))
-	// 	}
+	case kubeonev1beta3.SchemeGroupVersion.String():
+		v1beta3Cluster := kubeonev1beta3.NewKubeOneCluster()
+		if err = runtime.DecodeInto(kubeonescheme.Codecs.UniversalDecoder(), cluster, v1beta3Cluster); err != nil {
+			return nil, fail.Config(err, fmt.Sprintf("decoding %s", v1beta3Cluster.GroupVersionKind()))
+		}
+
+		internalCluster, err = DefaultedV1Beta3KubeOneCluster(v1beta3Cluster, tfOutput)
+		if err != nil {
+			return nil, err
+		}
+	case kubeonev1beta4.SchemeGroupVersion.String():
+		v1beta4Cluster := kubeonev1beta4.NewKubeOneCluster()
+		if err = runtime.DecodeInto(kubeonescheme.Codecs.UniversalDecoder(), cluster, v1beta4Cluster); err != nil {
+			return nil, fail.Config(err, fmt.Sprintf("decoding %s", v1beta4Cluster.GroupVersionKind()))
+		}
 
-	// 	internalCluster, err = DefaultedV1Beta3KubeOneCluster(v1beta3Cluster, tfOutput, credentialsFile, logger)
-	// 	if err != nil {
-	// 		return nil, err
-	// 	}
+		internalCluster, err = DefaultedV1Beta4KubeOneCluster(v1beta4Cluster, tfOutput)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fail.Config(fmt.Errorf("invalid api version %q", typeMeta.APIVersion), "api version")
 	}
@@ -256,6 +275,63 @@ func DefaultedV1Beta3KubeOneCluster(versionedCluster *kubeonev1beta3.KubeOneClus
 	return internalCluster, nil
 }
 
+// DefaultedV1Beta4KubeOneCluster converts a v1beta4 KubeOneCluster object to an internal representation of KubeOneCluster
+// object while sourcing information from Terraform output, applying default values and validating the KubeOneCluster
+// object
+func DefaultedV1Beta4KubeOneCluster(versionedCluster *kubeonev1beta4.KubeOneCluster, tfOutput []byte) (*kubeoneapi.KubeOneCluster, error) {
+	if tfOutput != nil {
+		if err := applyTFOutputV1Beta4(versionedCluster, tfOutput); err != nil {
+			return nil, err
+		}
+	}
+
+	internalCluster := &kubeoneapi.KubeOneCluster{}
+
+	kubeonescheme.Scheme.Default(versionedCluster)
+	if err := kubeonescheme.Scheme.Convert(versionedCluster, internalCluster, nil); err != nil {
+		return nil, fail.Config(err, fmt.Sprintf("converting %s to internal object", versionedCluster.GroupVersionKind()))
+	}
+
+	return internalCluster, nil
+}
+
+// applyTFOutputV1Beta4 applies the Terraform output to the v1beta4 object. The
+// output has the v1beta3 shape, so it's applied to the v1beta3 copy of the
+// object, which holds everything the v1beta4 one does.
+func applyTFOutputV1Beta4(versionedCluster *kubeonev1beta4.KubeOneCluster, tfOutput []byte) error {
+	tfConfig, err := terraformv1beta3.NewConfigFromJSON(tfOutput)
+	if err != nil {
+		return err
+	}
+
+	var (
+		internalCluster = &kubeoneapi.KubeOneCluster{}
+		v1beta3Cluster  = kubeonev1beta3.NewKubeOneCluster()
+	)
+
+	if err = kubeonescheme.Scheme.Convert(versionedCluster, internalCluster, nil); err != nil {
+		return fail.Config(err, fmt.Sprintf("converting %s to internal object", versionedCluster.GroupVersionKind()))
+	}
+
+	if err = kubeonescheme.Scheme.Convert(internalCluster, v1beta3Cluster, nil); err != nil {
+		return fail.Config(err, "converting internal to v1beta3 object")
+	}
+
+	if err = tfConfig.Apply(v1beta3Cluster); err != nil {
+		return err
+	}
+
+	if err = kubeonescheme.Scheme.Convert(v1beta3Cluster, internalCluster, nil); err != nil {
+		return fail.Config(err, fmt.Sprintf("converting %s to internal object", v1beta3Cluster.GroupVersionKind()))
+	}
+
+	if err = kubeonescheme.Scheme.Convert(internalCluster, versionedCluster, nil); err != nil {
+		return fail.Config(err, fmt.Sprintf("converting internal to %s object", versionedCluster.GroupVersionKind()))
+	}
+
+	return nil
+}
+
 // SetKubeOneClusterDynamicDefaults sets the dynamic defaults for a given KubeOneCluster object
 func SetKubeOneClusterDynamicDefaults(cluster *kubeoneapi.KubeOneCluster, credentialsFilePath, baseDir string) error {
 	// Set the default cloud config
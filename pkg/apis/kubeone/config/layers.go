@@ -0,0 +1,435 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"k8c.io/kubeone/pkg/fail"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// patchDirective is the key of the strategic merge directives. The
+	// "delete" directive removes the list item, and the "replace" directive
+	// replaces the object instead of merging it.
+	patchDirective = "$patch"
+	patchDelete    = "delete"
+	patchReplace   = "replace"
+)
+
+// mergeKeys are the fields identifying the list items, like the hosts by the
+// publicAddress and the dynamic workers by the name. The items of the lists
+// with the merge key are merged, the other lists are replaced.
+var mergeKeys = []string{"name", "publicAddress"}
+
+// pathFields are the fields holding the paths to the local files, like the
+// SSH keys of the hosts and the configs of the features. Together with the
+// addons path and the CA bundle file, their relative paths are resolved
+// against the directory of the manifest setting them.
+var pathFields = []string{
+	"bastionPrivateKeyFile",
+	"certFile",
+	"configFilePath",
+	"policyFilePath",
+	"privateKeyFile",
+	"sshCertFile",
+	"sshPrivateKeyFile",
+	"valuesFile",
+}
+
+// Provenance records the manifest file each value of the merged
+// KubeOneCluster manifests came from
+type Provenance struct {
+	// sources are the manifest files, by the path of the value
+	sources map[string]string
+}
+
+// ReadManifests reads the KubeOneCluster manifests and merges them in order,
+// each one patching the result of the previous ones with the strategic merge
+// semantics:
+//
+//   - the objects are merged, and the null values remove the fields
+//   - the list items with the same name or publicAddress are merged, the new
+//     ones are appended, and the ones with "$patch: delete" are removed
+//   - the other lists and the objects with "$patch: replace" are replaced
+//
+// The relative paths of each manifest are resolved against its directory
+// before merging it. A single manifest is returned as it is.
+func ReadManifests(clusterCfgPaths []string) ([]byte, *Provenance, error) {
+	var (
+		manifest   []byte
+		merged     any = map[string]any{}
+		provenance     = &Provenance{sources: map[string]string{}}
+	)
+
+	for _, clusterCfgPath := range clusterCfgPaths {
+		buf, err := os.ReadFile(clusterCfgPath)
+		if err != nil {
+			return nil, nil, fail.Runtime(err, "reading cluster configuration")
+		}
+		manifest = buf
+
+		layer, err := decodeManifestLayer(buf)
+		if err != nil {
+			return nil, nil, fail.Config(err, fmt.Sprintf("decoding %s", clusterCfgPath))
+		}
+
+		layerDir, err := filepath.Abs(filepath.Dir(clusterCfgPath))
+		if err != nil {
+			return nil, nil, fail.Runtime(err, "getting absolute path to the cluster configuration")
+		}
+		resolveLayerPaths(layer, layerDir)
+
+		if err = checkLayerTypeMeta(merged.(map[string]any), layer); err != nil {
+			return nil, nil, fail.ConfigValidation(errors.Wrap(err, clusterCfgPath))
+		}
+
+		if merged, err = provenance.merge(merged, layer, nil, clusterCfgPath); err != nil {
+			return nil, nil, fail.Config(err, fmt.Sprintf("merging %s", clusterCfgPath))
+		}
+	}
+
+	if len(clusterCfgPaths) == 1 {
+		return manifest, provenance, nil
+	}
+
+	manifest, err := json.Marshal(merged)
+	if err != nil {
+		return nil, nil, fail.Runtime(err, "encoding merged cluster configuration")
+	}
+
+	return manifest, provenance, nil
+}
+
+// decodeManifestLayer decodes the YAML manifest keeping the numbers as they
+// are written
+func decodeManifestLayer(buf []byte) (map[string]any, error) {
+	jsonBuf, err := yaml.YAMLToJSON(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	var layer map[string]any
+
+	dec := json.NewDecoder(bytes.NewReader(jsonBuf))
+	dec.UseNumber()
+	if err = dec.Decode(&layer); err != nil {
+		return nil, err
+	}
+
+	if layer == nil {
+		layer = map[string]any{}
+	}
+
+	return layer, nil
+}
+
+// resolveLayerPaths makes the relative paths to the local files of the
+// manifest absolute, so they don't depend on the manifest merging it
+func resolveLayerPaths(layer map[string]any, dir string) {
+	for _, field := range [][]string{{"addons", "path"}, {"certificateAuthority", "file"}} {
+		if fields, ok := layer[field[0]].(map[string]any); ok {
+			resolveLayerPath(fields, field[1], dir)
+		}
+	}
+
+	resolveLayerPathFields(layer, dir)
+}
+
+func resolveLayerPathFields(value any, dir string) {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, fieldValue := range value {
+			if slices.Contains(pathFields, key) {
+				resolveLayerPath(value, key, dir)
+
+				continue
+			}
+
+			resolveLayerPathFields(fieldValue, dir)
+		}
+	case []any:
+		for _, item := range value {
+			resolveLayerPathFields(item, dir)
+		}
+	}
+}
+
+// resolveLayerPath joins the relative path of the field to the directory,
+// leaving the paths starting with the home directory as they are
+func resolveLayerPath(fields map[string]any, key, dir string) {
+	path, ok := fields[key].(string)
+	if !ok || path == "" || filepath.IsAbs(path) || strings.HasPrefix(path, "~") {
+		return
+	}
+
+	fields[key] = filepath.Join(dir, path)
+}
+
+// checkLayerTypeMeta checks the manifest doesn't change the apiVersion or the
+// kind of the previous manifests, which the patches may omit
+func checkLayerTypeMeta(merged, layer map[string]any) error {
+	for _, field := range []string{"apiVersion", "kind"} {
+		previous, ok := merged[field]
+		if !ok {
+			continue
+		}
+
+		if current, ok := layer[field]; ok && current != previous {
+			return errors.Errorf("%s %q doesn't match %q of the previous manifests", field, current, previous)
+		}
+	}
+
+	return nil
+}
+
+func (p *Provenance) merge(base, patch any, path []string, file string) (any, error) {
+	switch patch := patch.(type) {
+	case map[string]any:
+		baseMap, ok := base.(map[string]any)
+
+		switch directive := patch[patchDirective]; directive {
+		case nil:
+		case patchReplace:
+			ok = false
+			delete(patch, patchDirective)
+		default:
+			return nil, errors.Errorf("unknown %s directive %q at %s", patchDirective, directive, valuePath(path))
+		}
+
+		if !ok {
+			p.forget(path)
+			baseMap = map[string]any{}
+		}
+
+		if len(baseMap) == 0 && len(patch) == 0 {
+			p.sources[provenanceKey(path)] = file
+		}
+
+		for key, value := range patch {
+			fieldPath := appendPath(path, key)
+
+			if value == nil {
+				delete(baseMap, key)
+				p.forget(fieldPath)
+
+				continue
+			}
+
+			merged, err := p.merge(baseMap[key], value, fieldPath, file)
+			if err != nil {
+				return nil, err
+			}
+			baseMap[key] = merged
+		}
+
+		return baseMap, nil
+	case []any:
+		baseList, _ := base.([]any)
+
+		key := mergeKey(baseList, patch)
+		if key == "" {
+			p.forget(path)
+			p.sources[provenanceKey(path)] = file
+
+			return patch, nil
+		}
+
+		for _, item := range patch {
+			item := item.(map[string]any)
+			itemPath := appendPath(path, listItemSegment(key, item[key]))
+			i := slices.IndexFunc(baseList, func(baseItem any) bool {
+				return baseItem.(map[string]any)[key] == item[key]
+			})
+
+			if item[patchDirective] == patchDelete {
+				if i >= 0 {
+					baseList = slices.Delete(baseList, i, i+1)
+				}
+				p.forget(itemPath)
+
+				continue
+			}
+
+			var baseItem any
+			if i >= 0 {
+				baseItem = baseList[i]
+			}
+
+			merged, err := p.merge(baseItem, item, itemPath, file)
+			if err != nil {
+				return nil, err
+			}
+
+			if i >= 0 {
+				baseList[i] = merged
+			} else {
+				baseList = append(baseList, merged)
+			}
+		}
+
+		return baseList, nil
+	default:
+		p.forget(path)
+		p.sources[provenanceKey(path)] = file
+
+		return patch, nil
+	}
+}
+
+// forget removes the sources of the value and everything under it
+func (p *Provenance) forget(path []string) {
+	key := provenanceKey(path)
+
+	for valueKey := range p.sources {
+		if len(path) == 0 || valueKey == key || strings.HasPrefix(valueKey, key+"\x00") {
+			delete(p.sources, valueKey)
+		}
+	}
+}
+
+// Source returns the manifest file the value at the path came from, or the
+// empty string for the values not coming from the manifests, like the
+// defaults. The items of the replaced lists come from the file of the list.
+func (p *Provenance) Source(path ...string) string {
+	for i := len(path); i > 0; i-- {
+		if source, ok := p.sources[provenanceKey(path[:i])]; ok {
+			return source
+		}
+	}
+
+	return ""
+}
+
+// Annotate comments the values of the YAML manifest with the manifest files
+// they came from
+func (p *Provenance) Annotate(manifest []byte) ([]byte, error) {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(manifest, &doc); err != nil {
+		return nil, fail.Runtime(err, "unmarshalling manifest")
+	}
+
+	p.annotate(&doc, nil)
+
+	var buf bytes.Buffer
+
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fail.Runtime(err, "marshalling annotated manifest")
+	}
+
+	return buf.Bytes(), fail.Runtime(enc.Close(), "marshalling annotated manifest")
+}
+
+func (p *Provenance) annotate(node *yamlv3.Node, path []string) {
+	switch node.Kind {
+	case yamlv3.DocumentNode:
+		for _, content := range node.Content {
+			p.annotate(content, path)
+		}
+	case yamlv3.MappingNode:
+		if len(node.Content) == 0 {
+			node.LineComment = p.Source(path...)
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			p.annotate(node.Content[i+1], appendPath(path, node.Content[i].Value))
+		}
+	case yamlv3.SequenceNode:
+		if len(node.Content) == 0 {
+			node.LineComment = p.Source(path...)
+		}
+
+		items := make([]any, len(node.Content))
+		for i, item := range node.Content {
+			if err := item.Decode(&items[i]); err != nil {
+				items = nil
+
+				break
+			}
+		}
+		key := mergeKey(nil, items)
+
+		for i, item := range node.Content {
+			segment := "[" + strconv.Itoa(i) + "]"
+			if key != "" {
+				segment = listItemSegment(key, items[i].(map[string]any)[key])
+			}
+
+			p.annotate(item, appendPath(path, segment))
+		}
+	default:
+		node.LineComment = p.Source(path...)
+	}
+}
+
+// mergeKey returns the merge key all items of the lists have, or the empty
+// string if the lists are replaced instead of merged
+func mergeKey(baseList, patch []any) string {
+	if len(patch) == 0 {
+		return ""
+	}
+
+	for _, key := range mergeKeys {
+		hasKey := func(item any) bool {
+			itemMap, ok := item.(map[string]any)
+			if !ok {
+				return false
+			}
+
+			value, ok := itemMap[key].(string)
+
+			return ok && value != ""
+		}
+
+		if !slices.ContainsFunc(baseList, func(item any) bool { return !hasKey(item) }) &&
+			!slices.ContainsFunc(patch, func(item any) bool { return !hasKey(item) }) {
+			return key
+		}
+	}
+
+	return ""
+}
+
+func listItemSegment(key string, value any) string {
+	return fmt.Sprintf("[%s=%v]", key, value)
+}
+
+func appendPath(path []string, segment string) []string {
+	return append(slices.Clone(path), segment)
+}
+
+func provenanceKey(path []string) string {
+	return strings.Join(path, "\x00")
+}
+
+func valuePath(path []string) string {
+	return "." + strings.Join(path, ".")
+}
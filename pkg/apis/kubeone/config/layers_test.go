@@ -0,0 +1,344 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+const baseManifest = `
+apiVersion: kubeone.k8c.io/v1beta4
+kind: KubeOneCluster
+name: base
+versions:
+  kubernetes: 1.30.1
+apiEndpoint:
+  alternativeNames: [base.example.com]
+controlPlane:
+  hosts:
+  - publicAddress: 10.0.0.1
+    sshUsername: root
+  - publicAddress: 10.0.0.2
+    sshUsername: root
+dynamicWorkers:
+- name: pool1
+  replicas: 1
+- name: pool2
+  replicas: 1
+registryConfiguration:
+  overwriteRegistry: registry.example.com
+  insecureRegistry: true
+`
+
+// writeManifests writes the manifests to the files named base.yaml,
+// layer1.yaml, ... and returns the file names
+func writeManifests(t *testing.T, manifests ...string) []string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	var files []string
+	for i, manifest := range manifests {
+		name := "base.yaml"
+		if i > 0 {
+			name = fmt.Sprintf("layer%d.yaml", i)
+		}
+
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, []byte(manifest), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, file)
+	}
+
+	return files
+}
+
+func TestReadManifests(t *testing.T) {
+	tests := []struct {
+		name    string
+		layers  []string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "single manifest",
+			layers: nil,
+			want:   baseManifest,
+		},
+		{
+			name: "objects merged",
+			layers: []string{`
+versions:
+  kubernetes: 1.31.0
+registryConfiguration:
+  insecureRegistry: null
+`},
+			want: strings.NewReplacer("1.30.1", "1.31.0", "  insecureRegistry: true\n", "").Replace(baseManifest),
+		},
+		{
+			name: "hosts merged by publicAddress",
+			layers: []string{`
+controlPlane:
+  hosts:
+  - publicAddress: 10.0.0.2
+    sshUsername: ubuntu
+  - publicAddress: 10.0.0.3
+    sshUsername: ubuntu
+`},
+			want: strings.Replace(baseManifest, `  - publicAddress: 10.0.0.2
+    sshUsername: root
+`, `  - publicAddress: 10.0.0.2
+    sshUsername: ubuntu
+  - publicAddress: 10.0.0.3
+    sshUsername: ubuntu
+`, 1),
+		},
+		{
+			name: "workers merged by name",
+			layers: []string{`
+dynamicWorkers:
+- name: pool1
+  $patch: delete
+- name: pool2
+  replicas: 3
+`},
+			want: strings.Replace(baseManifest, `- name: pool1
+  replicas: 1
+- name: pool2
+  replicas: 1
+`, `- name: pool2
+  replicas: 3
+`, 1),
+		},
+		{
+			name: "lists without merge key replaced",
+			layers: []string{`
+apiEndpoint:
+  alternativeNames: [production.example.com]
+`},
+			want: strings.Replace(baseManifest, "base.example.com", "production.example.com", 1),
+		},
+		{
+			name: "object replaced",
+			layers: []string{`
+registryConfiguration:
+  $patch: replace
+  overwriteRegistry: production.example.com
+`},
+			want: strings.Replace(baseManifest, `  overwriteRegistry: registry.example.com
+  insecureRegistry: true
+`, `  overwriteRegistry: production.example.com
+`, 1),
+		},
+		{
+			name: "layers applied in order",
+			layers: []string{
+				"versions:\n  kubernetes: 1.31.0\n",
+				"versions:\n  kubernetes: 1.32.0\n",
+			},
+			want: strings.Replace(baseManifest, "1.30.1", "1.32.0", 1),
+		},
+		{
+			name:    "different apiVersion",
+			layers:  []string{"apiVersion: kubeone.k8c.io/v1beta3\n"},
+			wantErr: "apiVersion",
+		},
+		{
+			name:    "unknown directive",
+			layers:  []string{"registryConfiguration:\n  $patch: merge\n"},
+			wantErr: "unknown $patch directive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := writeManifests(t, append([]string{baseManifest}, tt.layers...)...)
+
+			got, _, err := ReadManifests(files)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ReadManifests() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotObj, wantObj any
+			if err = yaml.Unmarshal(got, &gotObj); err != nil {
+				t.Fatal(err)
+			}
+			if err = yaml.Unmarshal([]byte(tt.want), &wantObj); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotObj, wantObj) {
+				t.Errorf("ReadManifests() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadManifestsRelativePaths(t *testing.T) {
+	baseDir, layerDir := t.TempDir(), t.TempDir()
+
+	files := []string{filepath.Join(baseDir, "base.yaml"), filepath.Join(layerDir, "production.yaml")}
+	manifests := []string{`
+apiVersion: kubeone.k8c.io/v1beta4
+kind: KubeOneCluster
+addons:
+  enable: true
+  path: ./addons
+certificateAuthority:
+  file: ca.pem
+controlPlane:
+  hosts:
+  - publicAddress: 10.0.0.1
+    sshPrivateKeyFile: keys/id_rsa
+    sshCertFile: ~/.ssh/id_rsa-cert.pub
+  - publicAddress: 10.0.0.2
+    sshPrivateKeyFile: keys/id_rsa
+`, `
+certificateAuthority:
+  file: /etc/ssl/ca.pem
+controlPlane:
+  hosts:
+  - publicAddress: 10.0.0.2
+    sshPrivateKeyFile: keys/production
+    bastionPrivateKeyFile: ../bastion
+features:
+  staticAuditLog:
+    enable: true
+    config:
+      policyFilePath: audit/policy.yaml
+helmReleases:
+- chart: cilium
+  values:
+  - valuesFile: values/cilium.yaml
+`}
+
+	for i, file := range files {
+		if err := os.WriteFile(file, []byte(manifests[i]), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, _, err := ReadManifests(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cluster struct {
+		Addons struct {
+			Path string `json:"path"`
+		} `json:"addons"`
+		CertificateAuthority struct {
+			File string `json:"file"`
+		} `json:"certificateAuthority"`
+		ControlPlane struct {
+			Hosts []struct {
+				SSHPrivateKeyFile     string `json:"sshPrivateKeyFile"`
+				SSHCertFile           string `json:"sshCertFile"`
+				BastionPrivateKeyFile string `json:"bastionPrivateKeyFile"`
+			} `json:"hosts"`
+		} `json:"controlPlane"`
+		Features struct {
+			StaticAuditLog struct {
+				Config struct {
+					PolicyFilePath string `json:"policyFilePath"`
+				} `json:"config"`
+			} `json:"staticAuditLog"`
+		} `json:"features"`
+		HelmReleases []struct {
+			Values []struct {
+				ValuesFile string `json:"valuesFile"`
+			} `json:"values"`
+		} `json:"helmReleases"`
+	}
+	if err = yaml.Unmarshal(got, &cluster); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"addons path", cluster.Addons.Path, filepath.Join(baseDir, "addons")},
+		{"absolute path", cluster.CertificateAuthority.File, "/etc/ssl/ca.pem"},
+		{"base host key", cluster.ControlPlane.Hosts[0].SSHPrivateKeyFile, filepath.Join(baseDir, "keys/id_rsa")},
+		{"home directory", cluster.ControlPlane.Hosts[0].SSHCertFile, "~/.ssh/id_rsa-cert.pub"},
+		{"layer host key", cluster.ControlPlane.Hosts[1].SSHPrivateKeyFile, filepath.Join(layerDir, "keys/production")},
+		{"parent directory", cluster.ControlPlane.Hosts[1].BastionPrivateKeyFile, filepath.Join(filepath.Dir(layerDir), "bastion")},
+		{"feature config", cluster.Features.StaticAuditLog.Config.PolicyFilePath, filepath.Join(layerDir, "audit/policy.yaml")},
+		{"helm values", cluster.HelmReleases[0].Values[0].ValuesFile, filepath.Join(layerDir, "values/cilium.yaml")},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestProvenanceAnnotate(t *testing.T) {
+	files := writeManifests(t, baseManifest, `
+controlPlane:
+  hosts:
+  - publicAddress: 10.0.0.2
+    sshUsername: ubuntu
+apiEndpoint:
+  alternativeNames: [production.example.com]
+`)
+
+	manifest, provenance, err := ReadManifests(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the defaulted field is not in any manifest
+	manifest, err = yaml.JSONToYAML(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest = append(manifest, "clusterNetwork:\n  podSubnet: 10.244.0.0/16\n"...)
+
+	annotated, err := provenance.Annotate(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"name: base # " + files[0],
+		"- production.example.com # " + files[1],
+		"sshUsername: root # " + files[0],
+		"sshUsername: ubuntu # " + files[1],
+		"podSubnet: 10.244.0.0/16\n",
+	} {
+		if !strings.Contains(string(annotated), want) {
+			t.Errorf("annotated manifest doesn't contain %q:\n%s", want, annotated)
+		}
+	}
+}
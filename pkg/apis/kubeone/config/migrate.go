@@ -20,17 +20,23 @@ import (
 	"bytes"
 	"os"
 	"reflect"
+	"slices"
+	"strings"
 
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/apis/kubeone/scheme"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
 	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
+	kubeonev1beta4 "k8c.io/kubeone/pkg/apis/kubeone/v1beta4"
 	"k8c.io/kubeone/pkg/fail"
 	terraformv1beta2 "k8c.io/kubeone/pkg/terraform/v1beta2"
 	"k8c.io/kubeone/pkg/yamled"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kyaml "sigs.k8s.io/yaml"
 )
 
@@ -152,6 +158,238 @@ func MigrateV1beta2V1beta3(clusterFilePath string, tfOutput []byte) ([]byte, err
 	return yaml.Marshal(originalManifest)
 }
 
+// Migrate migrates the KubeOneCluster manifest to the latest API version,
+// chaining the migrations of the older API versions
+func Migrate(clusterFilePath string, tfOutput []byte) ([]byte, error) {
+	manifest, err := os.ReadFile(clusterFilePath)
+	if err != nil {
+		return nil, fail.Runtime(err, "reading manifest")
+	}
+
+	var typeMeta metav1.TypeMeta
+	if err = kyaml.Unmarshal(manifest, &typeMeta); err != nil {
+		return nil, fail.Config(err, "unmarshal cluster typeMeta")
+	}
+
+	switch typeMeta.APIVersion {
+	case kubeonev1beta2.SchemeGroupVersion.String():
+		manifest, err = MigrateV1beta2V1beta3(clusterFilePath, tfOutput)
+		if err != nil {
+			return nil, err
+		}
+	case kubeonev1beta3.SchemeGroupVersion.String():
+	case kubeonev1beta4.SchemeGroupVersion.String():
+		return nil, fail.ConfigValidation(errors.Errorf("the manifest is already %s", typeMeta.APIVersion))
+	default:
+		return nil, fail.ConfigValidation(errors.Errorf("migrating the %q manifest is not possible in this KubeOne version", typeMeta.APIVersion))
+	}
+
+	return migrateV1beta3V1beta4(manifest)
+}
+
+// MigrateV1beta3V1beta4 migrates KubeOneCluster v1beta3 object to v1beta4.
+// The fields are rewritten in the YAML node tree, so the comments are kept.
+func MigrateV1beta3V1beta4(clusterFilePath string) ([]byte, error) {
+	manifest, err := os.ReadFile(clusterFilePath)
+	if err != nil {
+		return nil, fail.Runtime(err, "loading cluster config to migrate")
+	}
+
+	return migrateV1beta3V1beta4(manifest)
+}
+
+func migrateV1beta3V1beta4(manifest []byte) ([]byte, error) {
+	var (
+		v1beta3KubeOneCluster  = kubeonev1beta3.NewKubeOneCluster()
+		v1beta4KubeOneCluster  = kubeonev1beta4.NewKubeOneCluster()
+		internalKubeOneCluster = new(kubeoneapi.KubeOneCluster)
+	)
+
+	if err := kyaml.UnmarshalStrict(manifest, v1beta3KubeOneCluster); err != nil {
+		return nil, fail.Runtime(err, "testing unmarshal v1beta3 KubeOneCluster")
+	}
+
+	if err := scheme.Scheme.Convert(v1beta3KubeOneCluster, internalKubeOneCluster, kubeoneapi.SchemeGroupVersion); err != nil {
+		return nil, fail.Config(err, "converting v1beta3/KubeOneCluster into internal KubeOneCluster")
+	}
+
+	if err := scheme.Scheme.Convert(internalKubeOneCluster, v1beta4KubeOneCluster, kubeonev1beta4.SchemeGroupVersion); err != nil {
+		return nil, fail.Config(err, "converting internal KubeOneCluster into v1beta4/KubeOneCluster")
+	}
+
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(manifest, &doc); err != nil {
+		return nil, fail.Config(err, "unmarshalling v1beta3 KubeOneCluster")
+	}
+
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yamlv3.MappingNode {
+		return nil, fail.ConfigValidation(errors.New("the manifest is not the KubeOneCluster object"))
+	}
+	root := doc.Content[0]
+
+	// the shapes of tlsCipherSuites and dynamicWorkers[].providerSpec.cloudProviderSpec
+	// didn't change in the YAML, only the fields below need rewriting
+	setMappingValue(root, "apiVersion", &yamlv3.Node{Kind: yamlv3.ScalarNode, Value: kubeonev1beta4.SchemeGroupVersion.String()})
+	migrateCABundle(root)
+	migrateAddonRefs(mappingValue(root, "addons"))
+
+	var buf bytes.Buffer
+
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fail.Runtime(err, "marshaling v1beta4 KubeOneCluster")
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fail.Runtime(err, "marshaling v1beta4 KubeOneCluster")
+	}
+
+	// make sure the migrated manifest is the valid v1beta4 object
+	if err := kyaml.UnmarshalStrict(buf.Bytes(), kubeonev1beta4.NewKubeOneCluster()); err != nil {
+		return nil, fail.Runtime(err, "testing unmarshal v1beta4 KubeOneCluster")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// migrateCABundle moves the caBundle to the certificateAuthority.bundle,
+// unless the certificate authority bundle or file is already set
+func migrateCABundle(root *yamlv3.Node) {
+	idx, key, caBundle := removeMappingKey(root, "caBundle")
+	if caBundle == nil {
+		return
+	}
+
+	certificateAuthority := mappingValue(root, "certificateAuthority")
+	if certificateAuthority == nil || certificateAuthority.Kind != yamlv3.MappingNode {
+		certificateAuthority = &yamlv3.Node{Kind: yamlv3.MappingNode}
+		if mappingValue(root, "certificateAuthority") != nil {
+			setMappingValue(root, "certificateAuthority", certificateAuthority)
+		} else {
+			insertMappingKey(root, idx, &yamlv3.Node{Kind: yamlv3.ScalarNode, Value: "certificateAuthority"}, certificateAuthority)
+		}
+	}
+
+	if mappingValue(certificateAuthority, "bundle") != nil || mappingValue(certificateAuthority, "file") != nil {
+		return
+	}
+
+	key.Value = "bundle"
+	insertMappingKey(certificateAuthority, len(certificateAuthority.Content)/2, key, caBundle)
+}
+
+// migrateAddonRefs splits the addons.addons references into the addons and
+// the helmReleases lists, keeping the comments of the references
+func migrateAddonRefs(addons *yamlv3.Node) {
+	refs := mappingValue(addons, "addons")
+	if refs == nil || refs.Kind != yamlv3.SequenceNode {
+		return
+	}
+
+	var addonItems, helmReleaseItems []*yamlv3.Node
+
+	for _, ref := range refs.Content {
+		for i := 0; ref.Kind == yamlv3.MappingNode && i+1 < len(ref.Content); i += 2 {
+			item := ref.Content[i+1]
+			moveComments(item, ref, ref.Content[i])
+
+			// the block mapping has no line of its own, so its line comment
+			// goes to the first key, which follows the "- " of the item
+			if item.Kind == yamlv3.MappingNode && len(item.Content) > 0 {
+				moveComments(item.Content[0], &yamlv3.Node{LineComment: item.LineComment})
+				item.LineComment = ""
+			}
+
+			switch ref.Content[i].Value {
+			case "addon":
+				addonItems = append(addonItems, item)
+			case "helmRelease":
+				helmReleaseItems = append(helmReleaseItems, item)
+			}
+		}
+	}
+
+	idx, key, _ := removeMappingKey(addons, "addons")
+	if len(addonItems) > 0 {
+		refs.Content = addonItems
+		insertMappingKey(addons, idx, key, refs)
+		idx++
+	}
+
+	if len(helmReleaseItems) > 0 {
+		helmReleases := &yamlv3.Node{Kind: yamlv3.SequenceNode, Style: refs.Style, Content: helmReleaseItems}
+		insertMappingKey(addons, idx, &yamlv3.Node{Kind: yamlv3.ScalarNode, Value: "helmReleases"}, helmReleases)
+	}
+}
+
+// mappingValue returns the value of the key of the mapping node, or nil if
+// the node is not the mapping or doesn't have the key
+func mappingValue(mapping *yamlv3.Node, key string) *yamlv3.Node {
+	if mapping == nil || mapping.Kind != yamlv3.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// setMappingValue replaces the value of the key of the mapping node, or
+// appends the key if it's not set
+func setMappingValue(mapping *yamlv3.Node, key string, value *yamlv3.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			moveComments(value, mapping.Content[i+1])
+			mapping.Content[i+1] = value
+
+			return
+		}
+	}
+
+	insertMappingKey(mapping, len(mapping.Content)/2, &yamlv3.Node{Kind: yamlv3.ScalarNode, Value: key}, value)
+}
+
+// removeMappingKey removes the key of the mapping node, returning its index
+// among the keys, the key and the value nodes. The index of the missing key is
+// the number of the keys, and the key and the value are nil.
+func removeMappingKey(mapping *yamlv3.Node, key string) (int, *yamlv3.Node, *yamlv3.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			keyNode, valueNode := mapping.Content[i], mapping.Content[i+1]
+			mapping.Content = slices.Delete(mapping.Content, i, i+2)
+
+			return i / 2, keyNode, valueNode
+		}
+	}
+
+	return len(mapping.Content) / 2, nil, nil
+}
+
+// insertMappingKey inserts the key and the value at the index among the keys
+// of the mapping node
+func insertMappingKey(mapping *yamlv3.Node, idx int, key, value *yamlv3.Node) {
+	mapping.Content = slices.Insert(mapping.Content, idx*2, key, value)
+}
+
+// moveComments moves the comments of the removed nodes to the node, the head
+// comments before its own and the line and foot comments after them
+func moveComments(node *yamlv3.Node, removed ...*yamlv3.Node) {
+	join := func(comments ...string) string {
+		return strings.Join(slices.DeleteFunc(comments, func(comment string) bool { return comment == "" }), "\n")
+	}
+
+	for _, r := range removed {
+		node.HeadComment = join(r.HeadComment, node.HeadComment)
+		node.LineComment = join(node.LineComment, r.LineComment)
+		node.FootComment = join(node.FootComment, r.FootComment)
+		r.HeadComment, r.LineComment, r.FootComment = "", "", ""
+	}
+}
+
 // loadClusterConfig takes path to the Cluster Config (old API) and returns yamled.Document
 func loadClusterConfig(oldConfigPath string) (*yamled.Document, error) {
 	f, err := os.Open(oldConfigPath)
@@ -19,6 +19,7 @@ package config
 import (
 	"flag"
 	"os"
+	"strings"
 	"testing"
 
 	"k8c.io/kubeone/pkg/testhelper"
@@ -31,9 +32,16 @@ func TestV1Beta2ToV1Beta3Migration(t *testing.T) {
 		"simple",
 		"just addons",
 		"helm",
+		"hooks",
+		"jump hosts",
 		"addons and helm",
 		"default api endpoint",
 		"default api endpoint with terraform output",
+		"execution",
+		"node health check",
+		"privilege escalation",
+		"retry",
+		"upgrade strategy",
 	}
 
 	for _, test := range tests {
@@ -57,3 +65,87 @@ func TestV1Beta2ToV1Beta3Migration(t *testing.T) {
 		})
 	}
 }
+
+func TestV1Beta3ToV1Beta4Migration(t *testing.T) {
+	tests := []string{
+		"simple",
+		"ca bundle",
+		"ca bundle overridden",
+		"addons and helm",
+		"just helm",
+		"comments",
+	}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			got, err := MigrateV1beta3V1beta4(testhelper.TestDataFSName(t, "_v1beta3.yaml"))
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), string(got), *updateFlag)
+		})
+	}
+}
+
+func TestV1Beta3ToV1Beta4MigrationComments(t *testing.T) {
+	got, err := MigrateV1beta3V1beta4("testdata/TestV1Beta3ToV1Beta4Migration-comments_v1beta3.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, comment := range []string{
+		"# production cluster",
+		"apiVersion: kubeone.k8c.io/v1beta4 # migrated by kubeone",
+		"# keep in sync with the CI",
+		"kubernetes: 1.30.1 # latest patch",
+		"# the corporate CA",
+		"# rotated yearly",
+		"# relative to the manifest",
+		"# the storage class",
+		"# the metrics",
+		"# pinned",
+		"# end of the addons",
+		"# end of the manifest",
+	} {
+		if !strings.Contains(string(got), comment) {
+			t.Errorf("migrated manifest lost %q:\n%s", comment, got)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name: "v1beta2",
+		},
+		{
+			name: "v1beta3",
+		},
+		{
+			name:    "v1beta4",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Migrate(testhelper.TestDataFSName(t, ".yaml"), nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Migrate() of the latest version succeeded")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), string(got), *updateFlag)
+		})
+	}
+}
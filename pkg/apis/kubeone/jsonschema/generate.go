@@ -0,0 +1,372 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	enumMarker                  = "kubebuilder:validation:Enum="
+	preserveUnknownFieldsMarker = "kubebuilder:pruning:PreserveUnknownFields"
+)
+
+// knownTypes are the schemas of the types with the custom JSON representation
+var knownTypes = map[reflect.Type]map[string]any{
+	reflect.TypeFor[metav1.Duration](): {"type": "string"},
+	reflect.TypeFor[metav1.Time]():     {"type": "string", "format": "date-time"},
+	reflect.TypeFor[intstr.IntOrString](): {
+		"anyOf":                      []any{map[string]any{"type": "integer"}, map[string]any{"type": "string"}},
+		"x-kubernetes-int-or-string": true,
+	},
+	reflect.TypeFor[runtime.RawExtension](): {"type": "object", "x-kubernetes-preserve-unknown-fields": true},
+	reflect.TypeFor[json.RawMessage]():      {"x-kubernetes-preserve-unknown-fields": true},
+}
+
+var extraNewlines = regexp.MustCompile(`\n{3,}`)
+
+// Generate returns the JSON Schema of the KubeOneCluster object of the API
+// version. The descriptions, the enum values and the markers are read from
+// the typesFile, which has the Go source of the object.
+func Generate(apiVersion string, obj runtime.Object, typesFile string) ([]byte, error) {
+	docs, err := parseDocs(typesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := reflect.TypeOf(obj).Elem()
+	g := &generator{
+		pkgPath:     typ.PkgPath(),
+		docs:        docs,
+		definitions: map[string]map[string]any{},
+	}
+
+	root := g.ref(typ)
+
+	// the apiVersion and the kind select the schema, so they are the only
+	// fields required before defaulting
+	object := g.definitions[typ.Name()]
+	properties := object["properties"].(map[string]any)
+	properties["apiVersion"] = map[string]any{"type": "string", "enum": []string{apiVersion}}
+	properties["kind"] = map[string]any{"type": "string", "enum": []string{typ.Name()}}
+	object["required"] = []string{"apiVersion", "kind"}
+
+	return marshal(map[string]any{
+		"$schema":     "http://json-schema.org/draft-07/schema#",
+		"title":       typ.Name() + " " + apiVersion,
+		"allOf":       []any{root},
+		"definitions": g.definitions,
+	})
+}
+
+type generator struct {
+	pkgPath     string
+	docs        *typeDocs
+	definitions map[string]map[string]any
+}
+
+func (g *generator) schemaOf(typ reflect.Type) map[string]any {
+	if schema, ok := knownTypes[typ]; ok {
+		return maps.Clone(schema)
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		return g.schemaOf(typ.Elem())
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		schema := map[string]any{"type": "string"}
+		if values, ok := g.docs.enums[typ.Name()]; ok && typ.PkgPath() == g.pkgPath {
+			schema["enum"] = values
+		}
+
+		return schema
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "format": "byte"}
+		}
+
+		return map[string]any{"type": "array", "items": g.schemaOf(typ.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schemaOf(typ.Elem())}
+	case reflect.Struct:
+		return g.ref(typ)
+	default:
+		return map[string]any{}
+	}
+}
+
+// ref returns the reference to the definition of the struct, adding the
+// definition when it's seen for the first time
+func (g *generator) ref(typ reflect.Type) map[string]any {
+	name := g.definitionName(typ)
+	ref := map[string]any{"$ref": "#/definitions/" + name}
+
+	if _, ok := g.definitions[name]; ok {
+		return ref
+	}
+	// the placeholder ends the recursion of the self-referencing types
+	g.definitions[name] = nil
+
+	properties := map[string]any{}
+	g.addProperties(typ, properties)
+
+	definition := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if doc := g.docs.types[typ.Name()]; doc != "" && typ.PkgPath() == g.pkgPath {
+		definition["description"] = doc
+	}
+	g.definitions[name] = definition
+
+	return ref
+}
+
+// addProperties adds the fields of the struct to the properties, flattening
+// the embedded structs like encoding/json does
+func (g *generator) addProperties(typ reflect.Type, properties map[string]any) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				g.addProperties(embedded, properties)
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = g.fieldSchema(typ, field)
+	}
+}
+
+func (g *generator) fieldSchema(owner reflect.Type, field reflect.StructField) map[string]any {
+	schema := g.schemaOf(field.Type)
+	if owner.PkgPath() != g.pkgPath {
+		return schema
+	}
+
+	key := owner.Name() + "." + field.Name
+	for _, marker := range g.docs.markers[key] {
+		switch {
+		case strings.HasPrefix(marker, enumMarker):
+			schema["enum"] = strings.Split(strings.TrimPrefix(marker, enumMarker), ";")
+		case marker == preserveUnknownFieldsMarker:
+			schema["x-kubernetes-preserve-unknown-fields"] = true
+		}
+	}
+
+	doc := g.docs.fields[key]
+	if doc == "" {
+		return schema
+	}
+
+	// the siblings of $ref are ignored, so the description wraps the
+	// reference
+	if _, ok := schema["$ref"]; ok {
+		return map[string]any{"description": doc, "allOf": []any{schema}}
+	}
+	schema["description"] = doc
+
+	return schema
+}
+
+// definitionName returns the name of the struct definition. The types of the
+// other packages are prefixed with the package path in the reverse domain
+// notation, like io.k8s.api.core.v1.Taint.
+func (g *generator) definitionName(typ reflect.Type) string {
+	if typ.PkgPath() == g.pkgPath {
+		return typ.Name()
+	}
+
+	pkgPath := strings.Split(typ.PkgPath(), "/")
+	domain := strings.Split(pkgPath[0], ".")
+	slices.Reverse(domain)
+
+	return strings.Join(slices.Concat(domain, pkgPath[1:], []string{typ.Name()}), ".")
+}
+
+// typeDocs is the documentation of the types read from the Go source
+type typeDocs struct {
+	// types are the descriptions of the types, by the type name
+	types map[string]string
+	// fields are the descriptions of the fields, by the "Type.Field" name
+	fields map[string]string
+	// markers are the +markers of the fields, by the "Type.Field" name
+	markers map[string][]string
+	// enums are the values of the typed string constants, by the type name
+	enums map[string][]string
+}
+
+func parseDocs(typesFile string) (*typeDocs, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), typesFile, nil, parser.ParseComments)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing %s", typesFile)
+	}
+
+	docs := &typeDocs{
+		types:   map[string]string{},
+		fields:  map[string]string{},
+		markers: map[string][]string{},
+		enums:   map[string][]string{},
+	}
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				doc := spec.Doc
+				if doc == nil {
+					doc = genDecl.Doc
+				}
+				docs.types[spec.Name.Name] = description(doc.Text())
+
+				structType, ok := spec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				for _, field := range structType.Fields.List {
+					for _, name := range field.Names {
+						key := spec.Name.Name + "." + name.Name
+						docs.fields[key] = description(field.Doc.Text())
+						docs.markers[key] = markers(field.Doc.Text())
+					}
+				}
+			case *ast.ValueSpec:
+				typeName, ok := spec.Type.(*ast.Ident)
+				if !ok || genDecl.Tok != token.CONST {
+					continue
+				}
+
+				for _, value := range spec.Values {
+					lit, ok := value.(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+
+					str, err := strconv.Unquote(lit.Value)
+					if err != nil {
+						return nil, errors.Wrapf(err, "parsing %s", typeName.Name)
+					}
+					docs.enums[typeName.Name] = append(docs.enums[typeName.Name], str)
+				}
+			}
+		}
+	}
+
+	return docs, nil
+}
+
+// description formats the doc comment like hack/apidoc-gen does, without
+// the markers, the TODOs and everything after "---"
+func description(doc string) string {
+	doc, _, _ = strings.Cut(doc, "---")
+
+	var (
+		buf       strings.Builder
+		lineStart = true
+	)
+
+	for line := range strings.SplitSeq(doc, "\n") {
+		line = strings.TrimRight(line, " \t")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			buf.WriteString("\n\n")
+			lineStart = true
+		case strings.HasPrefix(trimmed, "+"), strings.HasPrefix(trimmed, "TODO"):
+		case strings.HasPrefix(line, " "), strings.HasPrefix(line, "\t"):
+			// the indented lines, like the examples, are kept as they are
+			if !lineStart {
+				buf.WriteString("\n")
+			}
+			buf.WriteString(line + "\n")
+			lineStart = true
+		default:
+			if !lineStart {
+				buf.WriteString(" ")
+			}
+			buf.WriteString(line)
+			lineStart = false
+		}
+	}
+
+	return strings.TrimSpace(extraNewlines.ReplaceAllString(buf.String(), "\n\n"))
+}
+
+// markers returns the +markers of the doc comment, without the "+"
+func markers(doc string) []string {
+	var found []string
+
+	for line := range strings.SplitSeq(doc, "\n") {
+		if marker, ok := strings.CutPrefix(strings.TrimSpace(line), "+"); ok {
+			found = append(found, marker)
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonschema provides the JSON Schema and the OpenAPI v3 schema of
+// the served KubeOneCluster API versions, for validating and autocompleting
+// the manifests in the editors. The schemas are generated from the Go types
+// by hack/update-jsonschema.sh.
+package jsonschema
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
+	kubeonev1beta4 "k8c.io/kubeone/pkg/apis/kubeone/v1beta4"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// FormatJSONSchema is the JSON Schema (draft-07) format
+	FormatJSONSchema = "json-schema"
+	// FormatOpenAPI is the OpenAPI v3 format
+	FormatOpenAPI = "openapi"
+)
+
+// Version is the served API version of KubeOneCluster
+type Version struct {
+	// APIVersion is the apiVersion of the manifests
+	APIVersion string
+	// Object is the KubeOneCluster object of the API version
+	Object runtime.Object
+	// TypesFile is the Go source of the object, relative to the
+	// pkg/apis/kubeone directory
+	TypesFile string
+}
+
+// Versions are the served API versions of KubeOneCluster, the latest being
+// the last one. They match config.AllowedAPIs.
+var Versions = []Version{
+	{
+		APIVersion: kubeonev1beta2.SchemeGroupVersion.String(),
+		Object:     &kubeonev1beta2.KubeOneCluster{},
+		TypesFile:  "v1beta2/types.go",
+	},
+	{
+		APIVersion: kubeonev1beta3.SchemeGroupVersion.String(),
+		Object:     &kubeonev1beta3.KubeOneCluster{},
+		TypesFile:  "v1beta3/types.go",
+	},
+	{
+		APIVersion: kubeonev1beta4.SchemeGroupVersion.String(),
+		Object:     &kubeonev1beta4.KubeOneCluster{},
+		TypesFile:  "v1beta4/types.go",
+	},
+}
+
+//go:embed *.json
+var schemas embed.FS
+
+// FileName returns the name of the generated JSON Schema of the API version
+func FileName(apiVersion string) string {
+	return strings.ReplaceAll(apiVersion, "/", "_") + ".json"
+}
+
+// JSONSchema returns the JSON Schema of the KubeOneCluster of the API version
+func JSONSchema(apiVersion string) ([]byte, error) {
+	buf, err := schemas.ReadFile(FileName(apiVersion))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.Errorf("no schema for the %q API version", apiVersion)
+	}
+
+	return buf, err
+}
+
+// OpenAPI returns the OpenAPI v3 document with the schemas of the
+// KubeOneCluster of the API version and of all types it references
+func OpenAPI(apiVersion string) ([]byte, error) {
+	buf, err := JSONSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc struct {
+		Definitions map[string]any `json:"definitions"`
+	}
+	if err = json.Unmarshal(buf, &doc); err != nil {
+		return nil, errors.Wrap(err, "decoding JSON Schema")
+	}
+
+	return marshal(map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "KubeOneCluster",
+			"version": apiVersion,
+		},
+		"paths": map[string]any{},
+		"components": map[string]any{
+			"schemas": openAPIRefs(doc.Definitions),
+		},
+	})
+}
+
+// openAPIRefs points the references of the JSON Schema definitions to the
+// OpenAPI components
+func openAPIRefs(schema any) any {
+	switch schema := schema.(type) {
+	case map[string]any:
+		for key, value := range schema {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				schema[key] = strings.Replace(ref, "#/definitions/", "#/components/schemas/", 1)
+
+				continue
+			}
+			schema[key] = openAPIRefs(value)
+		}
+	case []any:
+		for i, value := range schema {
+			schema[i] = openAPIRefs(value)
+		}
+	}
+
+	return schema
+}
+
+func marshal(doc any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, errors.Wrap(err, "encoding schema")
+	}
+
+	return buf.Bytes(), nil
+}
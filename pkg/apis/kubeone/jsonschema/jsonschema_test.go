@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	kubeonev1beta4 "k8c.io/kubeone/pkg/apis/kubeone/v1beta4"
+)
+
+func TestSchemaUpToDate(t *testing.T) {
+	for _, version := range Versions {
+		t.Run(version.APIVersion, func(t *testing.T) {
+			want, err := Generate(version.APIVersion, version.Object, filepath.Join("..", version.TypesFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := JSONSchema(version.APIVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s is out of date, run hack/update-jsonschema.sh", FileName(version.APIVersion))
+			}
+		})
+	}
+}
+
+func TestSchemaEnums(t *testing.T) {
+	buf, err := JSONSchema(kubeonev1beta4.SchemeGroupVersion.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Definitions map[string]struct {
+			Properties map[string]struct {
+				Enum []string `json:"enum"`
+			} `json:"properties"`
+		} `json:"definitions"`
+	}
+	if err = json.Unmarshal(buf, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		definition string
+		property   string
+		want       []string
+	}{
+		{
+			name:       "apiVersion",
+			definition: "KubeOneCluster",
+			property:   "apiVersion",
+			want:       []string{"kubeone.k8c.io/v1beta4"},
+		},
+		{
+			name:       "operating system",
+			definition: "HostConfig",
+			property:   "operatingSystem",
+			want:       []string{"ubuntu", "debian", "centos", "rhel", "rockylinux", "flatcar", ""},
+		},
+		{
+			name:       "IP family",
+			definition: "ClusterNetworkConfig",
+			property:   "ipFamily",
+			want:       []string{"IPv4", "IPv6", "IPv4+IPv6", "IPv6+IPv4"},
+		},
+		{
+			name:       "webhook mode marker",
+			definition: "WebhookAuditLogConfig",
+			property:   "mode",
+			want:       []string{"batch", "blocking", "blocking-strict"},
+		},
+		{
+			name:       "etcd auto-compaction mode",
+			definition: "EtcdConfig",
+			property:   "autoCompactionMode",
+			want:       []string{"periodic", "revision"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := doc.Definitions[tt.definition].Properties[tt.property].Enum
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s.%s enum = %q, want %q", tt.definition, tt.property, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	buf, err := OpenAPI(kubeonev1beta4.SchemeGroupVersion.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(buf), "#/definitions/") {
+		t.Error("OpenAPI document references the JSON Schema definitions")
+	}
+
+	var doc struct {
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err = json.Unmarshal(buf, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := doc.Components.Schemas["KubeOneCluster"]; !ok {
+		t.Error("KubeOneCluster schema missing from the OpenAPI components")
+	}
+}
+
+func TestSchemaUnknownVersion(t *testing.T) {
+	if _, err := JSONSchema("kubeone.k8c.io/v1beta1"); err == nil {
+		t.Error("JSONSchema() of the unserved version succeeded")
+	}
+}
+
+func TestDescription(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{
+			name: "lines joined",
+			doc:  "Foo is\nthe foo.\n",
+			want: "Foo is the foo.",
+		},
+		{
+			name: "paragraphs kept",
+			doc:  "Foo is the foo.\n\nDefaults to bar.\n",
+			want: "Foo is the foo.\n\nDefaults to bar.",
+		},
+		{
+			name: "markers and TODOs dropped",
+			doc:  "Foo is the foo.\nTODO: remove\n+optional\n",
+			want: "Foo is the foo.",
+		},
+		{
+			name: "indented example kept",
+			doc:  "Foo is the foo, example:\n  foo: bar\nDefaults to bar.\n",
+			want: "Foo is the foo, example:\n  foo: bar\nDefaults to bar.",
+		},
+		{
+			name: "cut at the separator",
+			doc:  "Foo is the foo.\n---\nInternal notes\n",
+			want: "Foo is the foo.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := description(tt.doc); got != tt.want {
+				t.Errorf("description() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
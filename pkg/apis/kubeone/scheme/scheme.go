@@ -20,6 +20,7 @@ import (
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
 	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
+	kubeonev1beta4 "k8c.io/kubeone/pkg/apis/kubeone/v1beta4"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -44,5 +45,6 @@ func AddToScheme(scheme *runtime.Scheme) {
 	utilruntime.Must(kubeoneapi.AddToScheme(scheme))
 	utilruntime.Must(kubeonev1beta2.AddToScheme(scheme))
 	utilruntime.Must(kubeonev1beta3.AddToScheme(scheme))
-	utilruntime.Must(scheme.SetVersionPriority(kubeonev1beta3.SchemeGroupVersion))
+	utilruntime.Must(kubeonev1beta4.AddToScheme(scheme))
+	utilruntime.Must(scheme.SetVersionPriority(kubeonev1beta4.SchemeGroupVersion, kubeonev1beta3.SchemeGroupVersion))
 }
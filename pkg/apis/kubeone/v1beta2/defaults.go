@@ -379,6 +379,7 @@ func defaultHostConfig(obj *HostConfig) {
 	obj.SSHPort = defaults(obj.SSHPort, 22)
 	obj.BastionPort = defaults(obj.BastionPort, 22)
 	obj.BastionUser = defaults(obj.BastionUser, obj.SSHUsername)
+	obj.PrivilegeEscalation.Method = defaults(obj.PrivilegeEscalation.Method, PrivilegeEscalationSudo)
 }
 
 func defaults[T comparable](input, defaultValue T) T {
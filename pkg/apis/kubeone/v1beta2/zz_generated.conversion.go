@@ -30,6 +30,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -314,6 +315,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExecutionConfig)(nil), (*kubeone.ExecutionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ExecutionConfig_To_kubeone_ExecutionConfig(a.(*ExecutionConfig), b.(*kubeone.ExecutionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ExecutionConfig)(nil), (*ExecutionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ExecutionConfig_To_v1beta2_ExecutionConfig(a.(*kubeone.ExecutionConfig), b.(*ExecutionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExternalCNISpec)(nil), (*kubeone.ExternalCNISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ExternalCNISpec_To_kubeone_ExternalCNISpec(a.(*ExternalCNISpec), b.(*kubeone.ExternalCNISpec), scope)
 	}); err != nil {
@@ -394,6 +405,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Hook)(nil), (*kubeone.Hook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Hook_To_kubeone_Hook(a.(*Hook), b.(*kubeone.Hook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.Hook)(nil), (*Hook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_Hook_To_v1beta2_Hook(a.(*kubeone.Hook), b.(*Hook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Hooks)(nil), (*kubeone.Hooks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Hooks_To_kubeone_Hooks(a.(*Hooks), b.(*kubeone.Hooks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.Hooks)(nil), (*Hooks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_Hooks_To_v1beta2_Hooks(a.(*kubeone.Hooks), b.(*Hooks), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HostConfig)(nil), (*kubeone.HostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HostConfig_To_kubeone_HostConfig(a.(*HostConfig), b.(*kubeone.HostConfig), scope)
 	}); err != nil {
@@ -424,6 +455,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*JumpHost)(nil), (*kubeone.JumpHost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_JumpHost_To_kubeone_JumpHost(a.(*JumpHost), b.(*kubeone.JumpHost), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.JumpHost)(nil), (*JumpHost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_JumpHost_To_v1beta2_JumpHost(a.(*kubeone.JumpHost), b.(*JumpHost), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeProxyConfig)(nil), (*kubeone.KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_KubeProxyConfig_To_kubeone_KubeProxyConfig(a.(*KubeProxyConfig), b.(*kubeone.KubeProxyConfig), scope)
 	}); err != nil {
@@ -499,6 +540,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeHealthCheckConfig)(nil), (*kubeone.NodeHealthCheckConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(a.(*NodeHealthCheckConfig), b.(*kubeone.NodeHealthCheckConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NodeHealthCheckConfig)(nil), (*NodeHealthCheckConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NodeHealthCheckConfig_To_v1beta2_NodeHealthCheckConfig(a.(*kubeone.NodeHealthCheckConfig), b.(*NodeHealthCheckConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeLocalDNS)(nil), (*kubeone.NodeLocalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_NodeLocalDNS_To_kubeone_NodeLocalDNS(a.(*NodeLocalDNS), b.(*kubeone.NodeLocalDNS), scope)
 	}); err != nil {
@@ -639,6 +690,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PrivilegeEscalation)(nil), (*kubeone.PrivilegeEscalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(a.(*PrivilegeEscalation), b.(*kubeone.PrivilegeEscalation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.PrivilegeEscalation)(nil), (*PrivilegeEscalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_PrivilegeEscalation_To_v1beta2_PrivilegeEscalation(a.(*kubeone.PrivilegeEscalation), b.(*PrivilegeEscalation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*kubeone.ProviderSpec)(nil), (*ProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_ProviderSpec_To_v1beta2_ProviderSpec(a.(*kubeone.ProviderSpec), b.(*ProviderSpec), scope)
 	}); err != nil {
@@ -674,6 +735,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetryPolicy)(nil), (*kubeone.RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RetryPolicy_To_kubeone_RetryPolicy(a.(*RetryPolicy), b.(*kubeone.RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.RetryPolicy)(nil), (*RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_RetryPolicy_To_v1beta2_RetryPolicy(a.(*kubeone.RetryPolicy), b.(*RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHSpec)(nil), (*kubeone.SSHSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_SSHSpec_To_kubeone_SSHSpec(a.(*SSHSpec), b.(*kubeone.SSHSpec), scope)
 	}); err != nil {
@@ -714,6 +785,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StaticWorkersUpgradeStrategy)(nil), (*kubeone.StaticWorkersUpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(a.(*StaticWorkersUpgradeStrategy), b.(*kubeone.StaticWorkersUpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.StaticWorkersUpgradeStrategy)(nil), (*StaticWorkersUpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta2_StaticWorkersUpgradeStrategy(a.(*kubeone.StaticWorkersUpgradeStrategy), b.(*StaticWorkersUpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SystemPackages)(nil), (*kubeone.SystemPackages)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_SystemPackages_To_kubeone_SystemPackages(a.(*SystemPackages), b.(*kubeone.SystemPackages), scope)
 	}); err != nil {
@@ -1634,6 +1715,37 @@ func Convert_kubeone_EventRateLimitConfig_To_v1beta2_EventRateLimitConfig(in *ku
 	return autoConvert_kubeone_EventRateLimitConfig_To_v1beta2_EventRateLimitConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_ExecutionConfig_To_kubeone_ExecutionConfig(in *ExecutionConfig, out *kubeone.ExecutionConfig, s conversion.Scope) error {
+	out.Concurrency = in.Concurrency
+	if err := Convert_v1beta2_RetryPolicy_To_kubeone_RetryPolicy(&in.Retry, &out.Retry, s); err != nil {
+		return err
+	}
+	out.OperationRetry = *(*map[string]kubeone.RetryPolicy)(unsafe.Pointer(&in.OperationRetry))
+	return nil
+}
+
+// Convert_v1beta2_ExecutionConfig_To_kubeone_ExecutionConfig is an autogenerated conversion function.
+func Convert_v1beta2_ExecutionConfig_To_kubeone_ExecutionConfig(in *ExecutionConfig, out *kubeone.ExecutionConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_ExecutionConfig_To_kubeone_ExecutionConfig(in, out, s)
+}
+
+func autoConvert_kubeone_ExecutionConfig_To_v1beta2_ExecutionConfig(in *kubeone.ExecutionConfig, out *ExecutionConfig, s conversion.Scope) error {
+	out.Concurrency = in.Concurrency
+	if err := Convert_kubeone_RetryPolicy_To_v1beta2_RetryPolicy(&in.Retry, &out.Retry, s); err != nil {
+		return err
+	}
+	out.OperationRetry = *(*map[string]RetryPolicy)(unsafe.Pointer(&in.OperationRetry))
+	if err := Convert_kubeone_NodeHealthCheckConfig_To_v1beta2_NodeHealthCheckConfig(&in.NodeHealthCheck, &out.NodeHealthCheck, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_kubeone_ExecutionConfig_To_v1beta2_ExecutionConfig is an autogenerated conversion function.
+func Convert_kubeone_ExecutionConfig_To_v1beta2_ExecutionConfig(in *kubeone.ExecutionConfig, out *ExecutionConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_ExecutionConfig_To_v1beta2_ExecutionConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_ExternalCNISpec_To_kubeone_ExternalCNISpec(in *ExternalCNISpec, out *kubeone.ExternalCNISpec, s conversion.Scope) error {
 	return nil
 }
@@ -1858,6 +1970,58 @@ func Convert_kubeone_HetznerSpec_To_v1beta2_HetznerSpec(in *kubeone.HetznerSpec,
 	return autoConvert_kubeone_HetznerSpec_To_v1beta2_HetznerSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_Hook_To_kubeone_Hook(in *Hook, out *kubeone.Hook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Command = in.Command
+	out.Local = in.Local
+	out.FailurePolicy = kubeone.HookFailurePolicy(in.FailurePolicy)
+	return nil
+}
+
+// Convert_v1beta2_Hook_To_kubeone_Hook is an autogenerated conversion function.
+func Convert_v1beta2_Hook_To_kubeone_Hook(in *Hook, out *kubeone.Hook, s conversion.Scope) error {
+	return autoConvert_v1beta2_Hook_To_kubeone_Hook(in, out, s)
+}
+
+func autoConvert_kubeone_Hook_To_v1beta2_Hook(in *kubeone.Hook, out *Hook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Command = in.Command
+	out.Local = in.Local
+	out.FailurePolicy = HookFailurePolicy(in.FailurePolicy)
+	return nil
+}
+
+// Convert_kubeone_Hook_To_v1beta2_Hook is an autogenerated conversion function.
+func Convert_kubeone_Hook_To_v1beta2_Hook(in *kubeone.Hook, out *Hook, s conversion.Scope) error {
+	return autoConvert_kubeone_Hook_To_v1beta2_Hook(in, out, s)
+}
+
+func autoConvert_v1beta2_Hooks_To_kubeone_Hooks(in *Hooks, out *kubeone.Hooks, s conversion.Scope) error {
+	out.BeforeDrain = *(*[]kubeone.Hook)(unsafe.Pointer(&in.BeforeDrain))
+	out.AfterUpgrade = *(*[]kubeone.Hook)(unsafe.Pointer(&in.AfterUpgrade))
+	out.AfterJoin = *(*[]kubeone.Hook)(unsafe.Pointer(&in.AfterJoin))
+	out.BeforeReset = *(*[]kubeone.Hook)(unsafe.Pointer(&in.BeforeReset))
+	return nil
+}
+
+// Convert_v1beta2_Hooks_To_kubeone_Hooks is an autogenerated conversion function.
+func Convert_v1beta2_Hooks_To_kubeone_Hooks(in *Hooks, out *kubeone.Hooks, s conversion.Scope) error {
+	return autoConvert_v1beta2_Hooks_To_kubeone_Hooks(in, out, s)
+}
+
+func autoConvert_kubeone_Hooks_To_v1beta2_Hooks(in *kubeone.Hooks, out *Hooks, s conversion.Scope) error {
+	out.BeforeDrain = *(*[]Hook)(unsafe.Pointer(&in.BeforeDrain))
+	out.AfterUpgrade = *(*[]Hook)(unsafe.Pointer(&in.AfterUpgrade))
+	out.AfterJoin = *(*[]Hook)(unsafe.Pointer(&in.AfterJoin))
+	out.BeforeReset = *(*[]Hook)(unsafe.Pointer(&in.BeforeReset))
+	return nil
+}
+
+// Convert_kubeone_Hooks_To_v1beta2_Hooks is an autogenerated conversion function.
+func Convert_kubeone_Hooks_To_v1beta2_Hooks(in *kubeone.Hooks, out *Hooks, s conversion.Scope) error {
+	return autoConvert_kubeone_Hooks_To_v1beta2_Hooks(in, out, s)
+}
+
 func autoConvert_v1beta2_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *kubeone.HostConfig, s conversion.Scope) error {
 	out.ID = in.ID
 	out.PublicAddress = in.PublicAddress
@@ -1874,6 +2038,7 @@ func autoConvert_v1beta2_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *k
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
 	out.BastionPrivateKeyFile = in.BastionPrivateKeyFile
+	out.JumpHosts = *(*[]kubeone.JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	out.Hostname = in.Hostname
 	out.IsLeader = in.IsLeader
 	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
@@ -1883,6 +2048,9 @@ func autoConvert_v1beta2_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *k
 		return err
 	}
 	out.OperatingSystem = kubeone.OperatingSystemName(in.OperatingSystem)
+	if err := Convert_v1beta2_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(&in.PrivilegeEscalation, &out.PrivilegeEscalation, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1907,6 +2075,7 @@ func autoConvert_kubeone_HostConfig_To_v1beta2_HostConfig(in *kubeone.HostConfig
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
 	out.BastionPrivateKeyFile = in.BastionPrivateKeyFile
+	out.JumpHosts = *(*[]JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	out.Hostname = in.Hostname
 	out.IsLeader = in.IsLeader
 	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
@@ -1916,6 +2085,9 @@ func autoConvert_kubeone_HostConfig_To_v1beta2_HostConfig(in *kubeone.HostConfig
 		return err
 	}
 	out.OperatingSystem = OperatingSystemName(in.OperatingSystem)
+	if err := Convert_kubeone_PrivilegeEscalation_To_v1beta2_PrivilegeEscalation(&in.PrivilegeEscalation, &out.PrivilegeEscalation, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1972,6 +2144,34 @@ func Convert_kubeone_IPVSConfig_To_v1beta2_IPVSConfig(in *kubeone.IPVSConfig, ou
 	return autoConvert_kubeone_IPVSConfig_To_v1beta2_IPVSConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_JumpHost_To_kubeone_JumpHost(in *JumpHost, out *kubeone.JumpHost, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Port = in.Port
+	out.User = in.User
+	out.HostPublicKey = *(*[]byte)(unsafe.Pointer(&in.HostPublicKey))
+	out.PrivateKeyFile = in.PrivateKeyFile
+	return nil
+}
+
+// Convert_v1beta2_JumpHost_To_kubeone_JumpHost is an autogenerated conversion function.
+func Convert_v1beta2_JumpHost_To_kubeone_JumpHost(in *JumpHost, out *kubeone.JumpHost, s conversion.Scope) error {
+	return autoConvert_v1beta2_JumpHost_To_kubeone_JumpHost(in, out, s)
+}
+
+func autoConvert_kubeone_JumpHost_To_v1beta2_JumpHost(in *kubeone.JumpHost, out *JumpHost, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Port = in.Port
+	out.User = in.User
+	out.HostPublicKey = *(*[]byte)(unsafe.Pointer(&in.HostPublicKey))
+	out.PrivateKeyFile = in.PrivateKeyFile
+	return nil
+}
+
+// Convert_kubeone_JumpHost_To_v1beta2_JumpHost is an autogenerated conversion function.
+func Convert_kubeone_JumpHost_To_v1beta2_JumpHost(in *kubeone.JumpHost, out *JumpHost, s conversion.Scope) error {
+	return autoConvert_kubeone_JumpHost_To_v1beta2_JumpHost(in, out, s)
+}
+
 func autoConvert_v1beta2_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneCluster, out *kubeone.KubeOneCluster, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1beta2_ControlPlaneConfig_To_kubeone_ControlPlaneConfig(&in.ControlPlane, &out.ControlPlane, s); err != nil {
@@ -2040,6 +2240,12 @@ func autoConvert_v1beta2_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneClu
 		return err
 	}
 	out.ControlPlaneComponents = (*kubeone.ControlPlaneComponents)(unsafe.Pointer(in.ControlPlaneComponents))
+	if err := Convert_v1beta2_ExecutionConfig_To_kubeone_ExecutionConfig(&in.Execution, &out.Execution, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_Hooks_To_kubeone_Hooks(&in.Hooks, &out.Hooks, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2111,6 +2317,12 @@ func autoConvert_kubeone_KubeOneCluster_To_v1beta2_KubeOneCluster(in *kubeone.Ku
 		return err
 	}
 	out.ControlPlaneComponents = (*ControlPlaneComponents)(unsafe.Pointer(in.ControlPlaneComponents))
+	if err := Convert_kubeone_ExecutionConfig_To_v1beta2_ExecutionConfig(&in.Execution, &out.Execution, s); err != nil {
+		return err
+	}
+	if err := Convert_kubeone_Hooks_To_v1beta2_Hooks(&in.Hooks, &out.Hooks, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2304,6 +2516,28 @@ func Convert_kubeone_MetricsServer_To_v1beta2_MetricsServer(in *kubeone.MetricsS
 	return autoConvert_kubeone_MetricsServer_To_v1beta2_MetricsServer(in, out, s)
 }
 
+func autoConvert_v1beta2_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(in *NodeHealthCheckConfig, out *kubeone.NodeHealthCheckConfig, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	out.PodSelectors = *(*[]string)(unsafe.Pointer(&in.PodSelectors))
+	return nil
+}
+
+// Convert_v1beta2_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig is an autogenerated conversion function.
+func Convert_v1beta2_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(in *NodeHealthCheckConfig, out *kubeone.NodeHealthCheckConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(in, out, s)
+}
+
+func autoConvert_kubeone_NodeHealthCheckConfig_To_v1beta2_NodeHealthCheckConfig(in *kubeone.NodeHealthCheckConfig, out *NodeHealthCheckConfig, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	out.PodSelectors = *(*[]string)(unsafe.Pointer(&in.PodSelectors))
+	return nil
+}
+
+// Convert_kubeone_NodeHealthCheckConfig_To_v1beta2_NodeHealthCheckConfig is an autogenerated conversion function.
+func Convert_kubeone_NodeHealthCheckConfig_To_v1beta2_NodeHealthCheckConfig(in *kubeone.NodeHealthCheckConfig, out *NodeHealthCheckConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_NodeHealthCheckConfig_To_v1beta2_NodeHealthCheckConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_NodeLocalDNS_To_kubeone_NodeLocalDNS(in *NodeLocalDNS, out *kubeone.NodeLocalDNS, s conversion.Scope) error {
 	out.Deploy = in.Deploy
 	return nil
@@ -2646,6 +2880,28 @@ func Convert_kubeone_PodNodeSelectorConfig_To_v1beta2_PodNodeSelectorConfig(in *
 	return autoConvert_kubeone_PodNodeSelectorConfig_To_v1beta2_PodNodeSelectorConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(in *PrivilegeEscalation, out *kubeone.PrivilegeEscalation, s conversion.Scope) error {
+	out.Method = kubeone.PrivilegeEscalationMethod(in.Method)
+	out.Command = in.Command
+	return nil
+}
+
+// Convert_v1beta2_PrivilegeEscalation_To_kubeone_PrivilegeEscalation is an autogenerated conversion function.
+func Convert_v1beta2_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(in *PrivilegeEscalation, out *kubeone.PrivilegeEscalation, s conversion.Scope) error {
+	return autoConvert_v1beta2_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(in, out, s)
+}
+
+func autoConvert_kubeone_PrivilegeEscalation_To_v1beta2_PrivilegeEscalation(in *kubeone.PrivilegeEscalation, out *PrivilegeEscalation, s conversion.Scope) error {
+	out.Method = PrivilegeEscalationMethod(in.Method)
+	out.Command = in.Command
+	return nil
+}
+
+// Convert_kubeone_PrivilegeEscalation_To_v1beta2_PrivilegeEscalation is an autogenerated conversion function.
+func Convert_kubeone_PrivilegeEscalation_To_v1beta2_PrivilegeEscalation(in *kubeone.PrivilegeEscalation, out *PrivilegeEscalation, s conversion.Scope) error {
+	return autoConvert_kubeone_PrivilegeEscalation_To_v1beta2_PrivilegeEscalation(in, out, s)
+}
+
 func autoConvert_v1beta2_ProviderSpec_To_kubeone_ProviderSpec(in *ProviderSpec, out *kubeone.ProviderSpec, s conversion.Scope) error {
 	out.CloudProviderSpec = *(*jsontext.Value)(unsafe.Pointer(&in.CloudProviderSpec))
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
@@ -2758,6 +3014,30 @@ func Convert_kubeone_RegistryConfiguration_To_v1beta2_RegistryConfiguration(in *
 	return autoConvert_kubeone_RegistryConfiguration_To_v1beta2_RegistryConfiguration(in, out, s)
 }
 
+func autoConvert_v1beta2_RetryPolicy_To_kubeone_RetryPolicy(in *RetryPolicy, out *kubeone.RetryPolicy, s conversion.Scope) error {
+	out.Attempts = in.Attempts
+	out.InitialBackOff = in.InitialBackOff
+	out.MaxBackOff = in.MaxBackOff
+	return nil
+}
+
+// Convert_v1beta2_RetryPolicy_To_kubeone_RetryPolicy is an autogenerated conversion function.
+func Convert_v1beta2_RetryPolicy_To_kubeone_RetryPolicy(in *RetryPolicy, out *kubeone.RetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta2_RetryPolicy_To_kubeone_RetryPolicy(in, out, s)
+}
+
+func autoConvert_kubeone_RetryPolicy_To_v1beta2_RetryPolicy(in *kubeone.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	out.Attempts = in.Attempts
+	out.InitialBackOff = in.InitialBackOff
+	out.MaxBackOff = in.MaxBackOff
+	return nil
+}
+
+// Convert_kubeone_RetryPolicy_To_v1beta2_RetryPolicy is an autogenerated conversion function.
+func Convert_kubeone_RetryPolicy_To_v1beta2_RetryPolicy(in *kubeone.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	return autoConvert_kubeone_RetryPolicy_To_v1beta2_RetryPolicy(in, out, s)
+}
+
 func autoConvert_v1beta2_SSHSpec_To_kubeone_SSHSpec(in *SSHSpec, out *kubeone.SSHSpec, s conversion.Scope) error {
 	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
 	out.Port = in.Port
@@ -2770,6 +3050,7 @@ func autoConvert_v1beta2_SSHSpec_To_kubeone_SSHSpec(in *SSHSpec, out *kubeone.SS
 	out.BastionPort = in.BastionPort
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
+	out.JumpHosts = *(*[]kubeone.JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	return nil
 }
 
@@ -2790,6 +3071,7 @@ func autoConvert_kubeone_SSHSpec_To_v1beta2_SSHSpec(in *kubeone.SSHSpec, out *SS
 	out.BastionPort = in.BastionPort
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
+	out.JumpHosts = *(*[]JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	return nil
 }
 
@@ -2864,6 +3146,9 @@ func autoConvert_v1beta2_StaticWorkersConfig_To_kubeone_StaticWorkersConfig(in *
 	} else {
 		out.Hosts = nil
 	}
+	if err := Convert_v1beta2_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(&in.UpgradeStrategy, &out.UpgradeStrategy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2884,6 +3169,9 @@ func autoConvert_kubeone_StaticWorkersConfig_To_v1beta2_StaticWorkersConfig(in *
 	} else {
 		out.Hosts = nil
 	}
+	if err := Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta2_StaticWorkersUpgradeStrategy(&in.UpgradeStrategy, &out.UpgradeStrategy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2892,6 +3180,30 @@ func Convert_kubeone_StaticWorkersConfig_To_v1beta2_StaticWorkersConfig(in *kube
 	return autoConvert_kubeone_StaticWorkersConfig_To_v1beta2_StaticWorkersConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(in *StaticWorkersUpgradeStrategy, out *kubeone.StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.Canary = in.Canary
+	out.GroupByLabel = in.GroupByLabel
+	return nil
+}
+
+// Convert_v1beta2_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy is an autogenerated conversion function.
+func Convert_v1beta2_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(in *StaticWorkersUpgradeStrategy, out *kubeone.StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta2_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(in, out, s)
+}
+
+func autoConvert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta2_StaticWorkersUpgradeStrategy(in *kubeone.StaticWorkersUpgradeStrategy, out *StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.Canary = in.Canary
+	out.GroupByLabel = in.GroupByLabel
+	return nil
+}
+
+// Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta2_StaticWorkersUpgradeStrategy is an autogenerated conversion function.
+func Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta2_StaticWorkersUpgradeStrategy(in *kubeone.StaticWorkersUpgradeStrategy, out *StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	return autoConvert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta2_StaticWorkersUpgradeStrategy(in, out, s)
+}
+
 func autoConvert_v1beta2_SystemPackages_To_kubeone_SystemPackages(in *SystemPackages, out *kubeone.SystemPackages, s conversion.Scope) error {
 	out.ConfigureRepositories = in.ConfigureRepositories
 	return nil
@@ -21,6 +21,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -97,6 +98,12 @@ type KubeOneCluster struct {
 
 	// ControlPlaneComponents configures the Kubernetes control plane components
 	ControlPlaneComponents *ControlPlaneComponents `json:"controlPlaneComponents,omitempty"`
+
+	// Execution configures how KubeOne runs the tasks on the cluster nodes
+	Execution ExecutionConfig `json:"execution,omitempty"`
+
+	// Hooks are the custom commands run around the cluster operations
+	Hooks Hooks `json:"hooks,omitempty"`
 }
 
 type CertificateAuthorithyConfig struct {
@@ -231,6 +238,113 @@ type HelmValues struct {
 	Inline json.RawMessage `json:"inline,omitempty"`
 }
 
+// ExecutionConfig configures how KubeOne runs the tasks on the cluster nodes
+type ExecutionConfig struct {
+	// Concurrency is the maximum number of nodes a parallel task runs on at
+	// the same time. Zero means no limit.
+	// Default value: 0
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// Retry is the retry policy of the failed tasks
+	Retry RetryPolicy `json:"retry,omitempty"`
+
+	// OperationRetry overrides the retry policy for the tasks of the given
+	// operations, keyed by the operation name as printed in the logs, e.g.
+	// "applying addons"
+	OperationRetry map[string]RetryPolicy `json:"operationRetry,omitempty"`
+
+	// NodeHealthCheck configures the checks each node must pass after it's
+	// upgraded, before the upgrade moves on to the next node
+	NodeHealthCheck NodeHealthCheckConfig `json:"nodeHealthCheck,omitempty"`
+}
+
+// NodeHealthCheckConfig configures the checks of the upgraded node. The node
+// must be Ready and run the kubelet of the target version. The control plane
+// nodes must also run healthy kube-apiserver, kube-controller-manager,
+// kube-scheduler and etcd.
+type NodeHealthCheckConfig struct {
+	// Timeout is the maximum time to wait for the upgraded node to become
+	// healthy. The upgrade is halted if the node doesn't recover in time.
+	// Default value: 5m
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// PodSelectors are the label selectors of the additional pods, e.g.
+	// "app.kubernetes.io/name=ingress-nginx", which must be ready on the
+	// upgraded node
+	PodSelectors []string `json:"podSelectors,omitempty"`
+}
+
+// RetryPolicy configures how many times a failed task is attempted and how
+// long to wait between the attempts. The wait grows 1.4 times after each
+// attempt. Errors that can't be fixed by retrying, e.g. configuration errors,
+// are never retried.
+type RetryPolicy struct {
+	// Attempts is the maximum number of attempts, including the first one.
+	// Zero means the default of the task.
+	// Default value: 10
+	Attempts int `json:"attempts,omitempty"`
+
+	// InitialBackOff is the time to wait before the second attempt.
+	// Default value: 10s
+	InitialBackOff metav1.Duration `json:"initialBackOff,omitempty"`
+
+	// MaxBackOff is the maximum time to wait between the attempts. Zero means
+	// no limit.
+	// Default value: 0
+	MaxBackOff metav1.Duration `json:"maxBackOff,omitempty"`
+}
+
+// Hooks are the custom commands run at the given points of the cluster
+// operations. Hooks of each point are run in order.
+type Hooks struct {
+	// BeforeDrain hooks are run before the node is cordoned and drained
+	// for the upgrade
+	BeforeDrain []Hook `json:"beforeDrain,omitempty"`
+
+	// AfterUpgrade hooks are run after the node is upgraded, uncordoned and
+	// healthy again
+	AfterUpgrade []Hook `json:"afterUpgrade,omitempty"`
+
+	// AfterJoin hooks are run after the control plane or static worker node
+	// joined the cluster
+	AfterJoin []Hook `json:"afterJoin,omitempty"`
+
+	// BeforeReset hooks are run before the node is reset
+	BeforeReset []Hook `json:"beforeReset,omitempty"`
+}
+
+// Hook is the custom command run over SSH on the affected node, or locally on
+// the machine running KubeOne. The command is run by bash with the following
+// environment variables: KUBEONE_HOOK, KUBEONE_CLUSTER_NAME,
+// KUBEONE_NODE_HOSTNAME, KUBEONE_NODE_PUBLIC_ADDRESS and
+// KUBEONE_NODE_PRIVATE_ADDRESS.
+type Hook struct {
+	// Name identifies the hook in the logs
+	Name string `json:"name,omitempty"`
+
+	// Command is the shell command to run, e.g. the path to the script
+	Command string `json:"command"`
+
+	// Local runs the command on the machine running KubeOne instead of the
+	// affected node, in the directory of the KubeOne manifest
+	Local bool `json:"local,omitempty"`
+
+	// FailurePolicy defines what happens when the command fails. Possible
+	// values: Abort, Ignore.
+	// Default value: Abort
+	FailurePolicy HookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// HookFailurePolicy defines what happens when the hook fails
+type HookFailurePolicy string
+
+const (
+	// HookFailurePolicyAbort fails the operation
+	HookFailurePolicyAbort HookFailurePolicy = "Abort"
+	// HookFailurePolicyIgnore logs the failure and continues the operation
+	HookFailurePolicyIgnore HookFailurePolicy = "Ignore"
+)
+
 // LoggingConfig configures the Kubelet's log rotation
 type LoggingConfig struct {
 	// ContainerLogMaxSize configures the maximum size of container log file before it is rotated
@@ -323,7 +437,7 @@ type HostConfig struct {
 	// Default value is "root".
 	SSHUsername string `json:"sshUsername,omitempty"`
 
-	// SSHPrivateKeyFile is path to the file with PRIVATE AND CLEANTEXT ssh key.
+	// SSHPrivateKeyFile is path to the file with PRIVATE ssh key. The passphrase of the passphrase-protected key is provided with the --ssh-key-passphrase-file flag, the KUBEONE_SSH_KEY_PASSPHRASE environment variable or interactively.
 	// Default value is "".
 	SSHPrivateKeyFile string `json:"sshPrivateKeyFile,omitempty"`
 
@@ -353,10 +467,15 @@ type HostConfig struct {
 	// BastionHostPublicKey if not empty, will be used to verify bastion SSH public key
 	BastionHostPublicKey []byte `json:"bastionHostPublicKey,omitempty"`
 
-	// BastionPrivateKeyFile is path to the file with PRIVATE AND CLEANTEXT ssh key.
+	// BastionPrivateKeyFile is path to the file with PRIVATE ssh key. The passphrase of the passphrase-protected key is provided with the --ssh-key-passphrase-file flag, the KUBEONE_SSH_KEY_PASSPHRASE environment variable or interactively.
 	// Default value is "".
 	BastionPrivateKeyFile string `json:"bastionPrivateKeyFile,omitempty"`
 
+	// JumpHosts is a chain of the jump hosts to connect through, in the order
+	// they are dialed, like ProxyJump of OpenSSH. Mutually exclusive with .Bastion.
+	// Default value is [].
+	JumpHosts []JumpHost `json:"jumpHosts,omitempty"`
+
 	// Hostname is the hostname(1) of the host.
 	// Default value is populated at the runtime via running `hostname -f` command over ssh.
 	Hostname string `json:"hostname,omitempty"`
@@ -382,6 +501,58 @@ type HostConfig struct {
 
 	// OperatingSystem information, can be populated at the runtime.
 	OperatingSystem OperatingSystemName `json:"operatingSystem,omitempty"`
+
+	// PrivilegeEscalation configures how the commands requiring the root
+	// privileges are run on the host.
+	// Default value is sudo.
+	PrivilegeEscalation PrivilegeEscalation `json:"privilegeEscalation,omitempty"`
+}
+
+// PrivilegeEscalationMethod is the way the commands are run with the root privileges
+type PrivilegeEscalationMethod string
+
+const (
+	// PrivilegeEscalationSudo runs the commands with sudo
+	PrivilegeEscalationSudo PrivilegeEscalationMethod = "sudo"
+	// PrivilegeEscalationDoas runs the commands with doas
+	PrivilegeEscalationDoas PrivilegeEscalationMethod = "doas"
+	// PrivilegeEscalationNone runs the commands directly, the SSH user must be root
+	PrivilegeEscalationNone PrivilegeEscalationMethod = "none"
+	// PrivilegeEscalationCustom runs the commands with the custom command
+	PrivilegeEscalationCustom PrivilegeEscalationMethod = "custom"
+)
+
+// PrivilegeEscalation configures how the commands requiring the root privileges are run on the host
+type PrivilegeEscalation struct {
+	// Method is one of sudo, doas, none or custom.
+	// Default value is sudo.
+	Method PrivilegeEscalationMethod `json:"method,omitempty"`
+
+	// Command is the program, with its arguments, the commands are run with
+	// when the method is custom, e.g. "sudo -u admin" or "pfexec".
+	// Default value is "".
+	Command string `json:"command,omitempty"`
+}
+
+// JumpHost is an SSH jump host the connection to the node is proxied through
+type JumpHost struct {
+	// Address is an IP or hostname of the jump host.
+	Address string `json:"address"`
+
+	// Port is SSH port to use when connecting to the jump host.
+	// Default value is 22.
+	Port int `json:"port,omitempty"`
+
+	// User is system login name to use when connecting to the jump host.
+	// Default value is the SSH username of the node.
+	User string `json:"user,omitempty"`
+
+	// HostPublicKey if not empty, will be used to verify the jump host SSH public key
+	HostPublicKey []byte `json:"hostPublicKey,omitempty"`
+
+	// PrivateKeyFile is path to the file with PRIVATE ssh key used to authenticate to the jump host, passphrase-protected keys are supported like for SSHPrivateKeyFile.
+	// Default value is "", the keys used for the node are used.
+	PrivateKeyFile string `json:"privateKeyFile,omitempty"`
 }
 
 // ControlPlaneConfig defines control plane nodes
@@ -414,23 +585,48 @@ type OperatingSystemSpec struct {
 }
 
 type SSHSpec struct {
-	PublicKeys           []string `json:"publicKeys,omitempty"`
-	Port                 int      `json:"port,omitempty"`
-	Username             string   `json:"username,omitempty"`
-	PrivateKeyFile       string   `json:"privateKeyFile,omitempty"`
-	CertFile             string   `json:"certFile,omitempty"`
-	HostPublicKey        []byte   `json:"hostPublicKey,omitempty"`
-	AgentSocket          string   `json:"agentSocket,omitempty"`
-	Bastion              string   `json:"bastion,omitempty"`
-	BastionPort          int      `json:"bastionPort,omitempty"`
-	BastionUser          string   `json:"bastionUser,omitempty"`
-	BastionHostPublicKey []byte   `json:"bastionHostPublicKey,omitempty"`
+	PublicKeys           []string   `json:"publicKeys,omitempty"`
+	Port                 int        `json:"port,omitempty"`
+	Username             string     `json:"username,omitempty"`
+	PrivateKeyFile       string     `json:"privateKeyFile,omitempty"`
+	CertFile             string     `json:"certFile,omitempty"`
+	HostPublicKey        []byte     `json:"hostPublicKey,omitempty"`
+	AgentSocket          string     `json:"agentSocket,omitempty"`
+	Bastion              string     `json:"bastion,omitempty"`
+	BastionPort          int        `json:"bastionPort,omitempty"`
+	BastionUser          string     `json:"bastionUser,omitempty"`
+	BastionHostPublicKey []byte     `json:"bastionHostPublicKey,omitempty"`
+	JumpHosts            []JumpHost `json:"jumpHosts,omitempty"`
 }
 
 // StaticWorkersConfig defines static worker nodes provisioned by KubeOne and kubeadm
 type StaticWorkersConfig struct {
 	// Hosts
 	Hosts []HostConfig `json:"hosts,omitempty"`
+
+	// UpgradeStrategy configures how the static worker nodes are upgraded
+	UpgradeStrategy StaticWorkersUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+}
+
+// StaticWorkersUpgradeStrategy configures the rolling upgrade of the static
+// worker nodes, which are drained and upgraded in batches
+type StaticWorkersUpgradeStrategy struct {
+	// MaxUnavailable is the maximum number of the static worker nodes, or the
+	// percentage of them (e.g. "25%"), drained and upgraded at the same time.
+	// The percentage is rounded down, but at least one node is upgraded at a
+	// time.
+	// Default value: 1
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Canary upgrades the first static worker node alone, before upgrading
+	// the others in batches.
+	Canary bool `json:"canary,omitempty"`
+
+	// GroupByLabel is the key of the host label to group the static worker
+	// nodes by. The groups are upgraded one after another, in the order of
+	// their first node, and the MaxUnavailable percentage applies to the
+	// size of each group. Nodes without the label form their own group.
+	GroupByLabel string `json:"groupByLabel,omitempty"`
 }
 
 // KubeletConfig provides some kubelet configuration options
@@ -1123,6 +1319,7 @@ type WebhookAuditLogConfig struct {
 	// Batch causes the backend to buffer and write events asynchronously.
 	// Known modes are batch,blocking,blocking-strict.
 	// Defaults to batch.
+	// +kubebuilder:validation:Enum=batch;blocking;blocking-strict
 	Mode string `json:"mode,omitempty"`
 
 	// Version defines API group and version used for serializing audit events written to webhook.
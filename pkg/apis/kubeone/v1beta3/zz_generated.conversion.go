@@ -30,6 +30,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -334,6 +335,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExecutionConfig)(nil), (*kubeone.ExecutionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_ExecutionConfig_To_kubeone_ExecutionConfig(a.(*ExecutionConfig), b.(*kubeone.ExecutionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ExecutionConfig)(nil), (*ExecutionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ExecutionConfig_To_v1beta3_ExecutionConfig(a.(*kubeone.ExecutionConfig), b.(*ExecutionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExternalCNISpec)(nil), (*kubeone.ExternalCNISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_ExternalCNISpec_To_kubeone_ExternalCNISpec(a.(*ExternalCNISpec), b.(*kubeone.ExternalCNISpec), scope)
 	}); err != nil {
@@ -424,6 +435,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Hook)(nil), (*kubeone.Hook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_Hook_To_kubeone_Hook(a.(*Hook), b.(*kubeone.Hook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.Hook)(nil), (*Hook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_Hook_To_v1beta3_Hook(a.(*kubeone.Hook), b.(*Hook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Hooks)(nil), (*kubeone.Hooks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_Hooks_To_kubeone_Hooks(a.(*Hooks), b.(*kubeone.Hooks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.Hooks)(nil), (*Hooks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_Hooks_To_v1beta3_Hooks(a.(*kubeone.Hooks), b.(*Hooks), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HostConfig)(nil), (*kubeone.HostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_HostConfig_To_kubeone_HostConfig(a.(*HostConfig), b.(*kubeone.HostConfig), scope)
 	}); err != nil {
@@ -454,6 +485,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*JumpHost)(nil), (*kubeone.JumpHost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_JumpHost_To_kubeone_JumpHost(a.(*JumpHost), b.(*kubeone.JumpHost), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.JumpHost)(nil), (*JumpHost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_JumpHost_To_v1beta3_JumpHost(a.(*kubeone.JumpHost), b.(*JumpHost), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeOneCluster)(nil), (*kubeone.KubeOneCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_KubeOneCluster_To_kubeone_KubeOneCluster(a.(*KubeOneCluster), b.(*kubeone.KubeOneCluster), scope)
 	}); err != nil {
@@ -539,6 +580,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeHealthCheckConfig)(nil), (*kubeone.NodeHealthCheckConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(a.(*NodeHealthCheckConfig), b.(*kubeone.NodeHealthCheckConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NodeHealthCheckConfig)(nil), (*NodeHealthCheckConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NodeHealthCheckConfig_To_v1beta3_NodeHealthCheckConfig(a.(*kubeone.NodeHealthCheckConfig), b.(*NodeHealthCheckConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeLocalDNS)(nil), (*kubeone.NodeLocalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_NodeLocalDNS_To_kubeone_NodeLocalDNS(a.(*NodeLocalDNS), b.(*kubeone.NodeLocalDNS), scope)
 	}); err != nil {
@@ -679,6 +730,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PrivilegeEscalation)(nil), (*kubeone.PrivilegeEscalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(a.(*PrivilegeEscalation), b.(*kubeone.PrivilegeEscalation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.PrivilegeEscalation)(nil), (*PrivilegeEscalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_PrivilegeEscalation_To_v1beta3_PrivilegeEscalation(a.(*kubeone.PrivilegeEscalation), b.(*PrivilegeEscalation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderSpec)(nil), (*kubeone.ProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_ProviderSpec_To_kubeone_ProviderSpec(a.(*ProviderSpec), b.(*kubeone.ProviderSpec), scope)
 	}); err != nil {
@@ -719,6 +780,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetryPolicy)(nil), (*kubeone.RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_RetryPolicy_To_kubeone_RetryPolicy(a.(*RetryPolicy), b.(*kubeone.RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.RetryPolicy)(nil), (*RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_RetryPolicy_To_v1beta3_RetryPolicy(a.(*kubeone.RetryPolicy), b.(*RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHSpec)(nil), (*kubeone.SSHSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_SSHSpec_To_kubeone_SSHSpec(a.(*SSHSpec), b.(*kubeone.SSHSpec), scope)
 	}); err != nil {
@@ -759,6 +830,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StaticWorkersUpgradeStrategy)(nil), (*kubeone.StaticWorkersUpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(a.(*StaticWorkersUpgradeStrategy), b.(*kubeone.StaticWorkersUpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.StaticWorkersUpgradeStrategy)(nil), (*StaticWorkersUpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta3_StaticWorkersUpgradeStrategy(a.(*kubeone.StaticWorkersUpgradeStrategy), b.(*StaticWorkersUpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SystemPackages)(nil), (*kubeone.SystemPackages)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_SystemPackages_To_kubeone_SystemPackages(a.(*SystemPackages), b.(*kubeone.SystemPackages), scope)
 	}); err != nil {
@@ -1657,6 +1738,40 @@ func Convert_kubeone_EventRateLimitConfig_To_v1beta3_EventRateLimitConfig(in *ku
 	return autoConvert_kubeone_EventRateLimitConfig_To_v1beta3_EventRateLimitConfig(in, out, s)
 }
 
+func autoConvert_v1beta3_ExecutionConfig_To_kubeone_ExecutionConfig(in *ExecutionConfig, out *kubeone.ExecutionConfig, s conversion.Scope) error {
+	out.Concurrency = in.Concurrency
+	if err := Convert_v1beta3_RetryPolicy_To_kubeone_RetryPolicy(&in.Retry, &out.Retry, s); err != nil {
+		return err
+	}
+	out.OperationRetry = *(*map[string]kubeone.RetryPolicy)(unsafe.Pointer(&in.OperationRetry))
+	if err := Convert_v1beta3_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(&in.NodeHealthCheck, &out.NodeHealthCheck, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta3_ExecutionConfig_To_kubeone_ExecutionConfig is an autogenerated conversion function.
+func Convert_v1beta3_ExecutionConfig_To_kubeone_ExecutionConfig(in *ExecutionConfig, out *kubeone.ExecutionConfig, s conversion.Scope) error {
+	return autoConvert_v1beta3_ExecutionConfig_To_kubeone_ExecutionConfig(in, out, s)
+}
+
+func autoConvert_kubeone_ExecutionConfig_To_v1beta3_ExecutionConfig(in *kubeone.ExecutionConfig, out *ExecutionConfig, s conversion.Scope) error {
+	out.Concurrency = in.Concurrency
+	if err := Convert_kubeone_RetryPolicy_To_v1beta3_RetryPolicy(&in.Retry, &out.Retry, s); err != nil {
+		return err
+	}
+	out.OperationRetry = *(*map[string]RetryPolicy)(unsafe.Pointer(&in.OperationRetry))
+	if err := Convert_kubeone_NodeHealthCheckConfig_To_v1beta3_NodeHealthCheckConfig(&in.NodeHealthCheck, &out.NodeHealthCheck, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_kubeone_ExecutionConfig_To_v1beta3_ExecutionConfig is an autogenerated conversion function.
+func Convert_kubeone_ExecutionConfig_To_v1beta3_ExecutionConfig(in *kubeone.ExecutionConfig, out *ExecutionConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_ExecutionConfig_To_v1beta3_ExecutionConfig(in, out, s)
+}
+
 func autoConvert_v1beta3_ExternalCNISpec_To_kubeone_ExternalCNISpec(in *ExternalCNISpec, out *kubeone.ExternalCNISpec, s conversion.Scope) error {
 	return nil
 }
@@ -1891,6 +2006,58 @@ func Convert_kubeone_HetznerSpec_To_v1beta3_HetznerSpec(in *kubeone.HetznerSpec,
 	return autoConvert_kubeone_HetznerSpec_To_v1beta3_HetznerSpec(in, out, s)
 }
 
+func autoConvert_v1beta3_Hook_To_kubeone_Hook(in *Hook, out *kubeone.Hook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Command = in.Command
+	out.Local = in.Local
+	out.FailurePolicy = kubeone.HookFailurePolicy(in.FailurePolicy)
+	return nil
+}
+
+// Convert_v1beta3_Hook_To_kubeone_Hook is an autogenerated conversion function.
+func Convert_v1beta3_Hook_To_kubeone_Hook(in *Hook, out *kubeone.Hook, s conversion.Scope) error {
+	return autoConvert_v1beta3_Hook_To_kubeone_Hook(in, out, s)
+}
+
+func autoConvert_kubeone_Hook_To_v1beta3_Hook(in *kubeone.Hook, out *Hook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Command = in.Command
+	out.Local = in.Local
+	out.FailurePolicy = HookFailurePolicy(in.FailurePolicy)
+	return nil
+}
+
+// Convert_kubeone_Hook_To_v1beta3_Hook is an autogenerated conversion function.
+func Convert_kubeone_Hook_To_v1beta3_Hook(in *kubeone.Hook, out *Hook, s conversion.Scope) error {
+	return autoConvert_kubeone_Hook_To_v1beta3_Hook(in, out, s)
+}
+
+func autoConvert_v1beta3_Hooks_To_kubeone_Hooks(in *Hooks, out *kubeone.Hooks, s conversion.Scope) error {
+	out.BeforeDrain = *(*[]kubeone.Hook)(unsafe.Pointer(&in.BeforeDrain))
+	out.AfterUpgrade = *(*[]kubeone.Hook)(unsafe.Pointer(&in.AfterUpgrade))
+	out.AfterJoin = *(*[]kubeone.Hook)(unsafe.Pointer(&in.AfterJoin))
+	out.BeforeReset = *(*[]kubeone.Hook)(unsafe.Pointer(&in.BeforeReset))
+	return nil
+}
+
+// Convert_v1beta3_Hooks_To_kubeone_Hooks is an autogenerated conversion function.
+func Convert_v1beta3_Hooks_To_kubeone_Hooks(in *Hooks, out *kubeone.Hooks, s conversion.Scope) error {
+	return autoConvert_v1beta3_Hooks_To_kubeone_Hooks(in, out, s)
+}
+
+func autoConvert_kubeone_Hooks_To_v1beta3_Hooks(in *kubeone.Hooks, out *Hooks, s conversion.Scope) error {
+	out.BeforeDrain = *(*[]Hook)(unsafe.Pointer(&in.BeforeDrain))
+	out.AfterUpgrade = *(*[]Hook)(unsafe.Pointer(&in.AfterUpgrade))
+	out.AfterJoin = *(*[]Hook)(unsafe.Pointer(&in.AfterJoin))
+	out.BeforeReset = *(*[]Hook)(unsafe.Pointer(&in.BeforeReset))
+	return nil
+}
+
+// Convert_kubeone_Hooks_To_v1beta3_Hooks is an autogenerated conversion function.
+func Convert_kubeone_Hooks_To_v1beta3_Hooks(in *kubeone.Hooks, out *Hooks, s conversion.Scope) error {
+	return autoConvert_kubeone_Hooks_To_v1beta3_Hooks(in, out, s)
+}
+
 func autoConvert_v1beta3_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *kubeone.HostConfig, s conversion.Scope) error {
 	out.ID = in.ID
 	out.PublicAddress = in.PublicAddress
@@ -1907,6 +2074,7 @@ func autoConvert_v1beta3_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *k
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
 	out.BastionPrivateKeyFile = in.BastionPrivateKeyFile
+	out.JumpHosts = *(*[]kubeone.JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	out.Hostname = in.Hostname
 	out.IsLeader = in.IsLeader
 	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
@@ -1916,6 +2084,9 @@ func autoConvert_v1beta3_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *k
 		return err
 	}
 	out.OperatingSystem = kubeone.OperatingSystemName(in.OperatingSystem)
+	if err := Convert_v1beta3_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(&in.PrivilegeEscalation, &out.PrivilegeEscalation, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1940,6 +2111,7 @@ func autoConvert_kubeone_HostConfig_To_v1beta3_HostConfig(in *kubeone.HostConfig
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
 	out.BastionPrivateKeyFile = in.BastionPrivateKeyFile
+	out.JumpHosts = *(*[]JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	out.Hostname = in.Hostname
 	out.IsLeader = in.IsLeader
 	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
@@ -1949,6 +2121,9 @@ func autoConvert_kubeone_HostConfig_To_v1beta3_HostConfig(in *kubeone.HostConfig
 		return err
 	}
 	out.OperatingSystem = OperatingSystemName(in.OperatingSystem)
+	if err := Convert_kubeone_PrivilegeEscalation_To_v1beta3_PrivilegeEscalation(&in.PrivilegeEscalation, &out.PrivilegeEscalation, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2005,6 +2180,34 @@ func Convert_kubeone_IPVSConfig_To_v1beta3_IPVSConfig(in *kubeone.IPVSConfig, ou
 	return autoConvert_kubeone_IPVSConfig_To_v1beta3_IPVSConfig(in, out, s)
 }
 
+func autoConvert_v1beta3_JumpHost_To_kubeone_JumpHost(in *JumpHost, out *kubeone.JumpHost, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Port = in.Port
+	out.User = in.User
+	out.HostPublicKey = *(*[]byte)(unsafe.Pointer(&in.HostPublicKey))
+	out.PrivateKeyFile = in.PrivateKeyFile
+	return nil
+}
+
+// Convert_v1beta3_JumpHost_To_kubeone_JumpHost is an autogenerated conversion function.
+func Convert_v1beta3_JumpHost_To_kubeone_JumpHost(in *JumpHost, out *kubeone.JumpHost, s conversion.Scope) error {
+	return autoConvert_v1beta3_JumpHost_To_kubeone_JumpHost(in, out, s)
+}
+
+func autoConvert_kubeone_JumpHost_To_v1beta3_JumpHost(in *kubeone.JumpHost, out *JumpHost, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Port = in.Port
+	out.User = in.User
+	out.HostPublicKey = *(*[]byte)(unsafe.Pointer(&in.HostPublicKey))
+	out.PrivateKeyFile = in.PrivateKeyFile
+	return nil
+}
+
+// Convert_kubeone_JumpHost_To_v1beta3_JumpHost is an autogenerated conversion function.
+func Convert_kubeone_JumpHost_To_v1beta3_JumpHost(in *kubeone.JumpHost, out *JumpHost, s conversion.Scope) error {
+	return autoConvert_kubeone_JumpHost_To_v1beta3_JumpHost(in, out, s)
+}
+
 func autoConvert_v1beta3_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneCluster, out *kubeone.KubeOneCluster, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1beta3_ControlPlaneConfig_To_kubeone_ControlPlaneConfig(&in.ControlPlane, &out.ControlPlane, s); err != nil {
@@ -2054,6 +2257,12 @@ func autoConvert_v1beta3_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneClu
 		return err
 	}
 	out.ControlPlaneComponents = (*kubeone.ControlPlaneComponents)(unsafe.Pointer(in.ControlPlaneComponents))
+	if err := Convert_v1beta3_ExecutionConfig_To_kubeone_ExecutionConfig(&in.Execution, &out.Execution, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta3_Hooks_To_kubeone_Hooks(&in.Hooks, &out.Hooks, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2112,6 +2321,12 @@ func autoConvert_kubeone_KubeOneCluster_To_v1beta3_KubeOneCluster(in *kubeone.Ku
 		return err
 	}
 	out.ControlPlaneComponents = (*ControlPlaneComponents)(unsafe.Pointer(in.ControlPlaneComponents))
+	if err := Convert_kubeone_ExecutionConfig_To_v1beta3_ExecutionConfig(&in.Execution, &out.Execution, s); err != nil {
+		return err
+	}
+	if err := Convert_kubeone_Hooks_To_v1beta3_Hooks(&in.Hooks, &out.Hooks, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2311,6 +2526,28 @@ func Convert_kubeone_MetricsServer_To_v1beta3_MetricsServer(in *kubeone.MetricsS
 	return autoConvert_kubeone_MetricsServer_To_v1beta3_MetricsServer(in, out, s)
 }
 
+func autoConvert_v1beta3_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(in *NodeHealthCheckConfig, out *kubeone.NodeHealthCheckConfig, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	out.PodSelectors = *(*[]string)(unsafe.Pointer(&in.PodSelectors))
+	return nil
+}
+
+// Convert_v1beta3_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig is an autogenerated conversion function.
+func Convert_v1beta3_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(in *NodeHealthCheckConfig, out *kubeone.NodeHealthCheckConfig, s conversion.Scope) error {
+	return autoConvert_v1beta3_NodeHealthCheckConfig_To_kubeone_NodeHealthCheckConfig(in, out, s)
+}
+
+func autoConvert_kubeone_NodeHealthCheckConfig_To_v1beta3_NodeHealthCheckConfig(in *kubeone.NodeHealthCheckConfig, out *NodeHealthCheckConfig, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	out.PodSelectors = *(*[]string)(unsafe.Pointer(&in.PodSelectors))
+	return nil
+}
+
+// Convert_kubeone_NodeHealthCheckConfig_To_v1beta3_NodeHealthCheckConfig is an autogenerated conversion function.
+func Convert_kubeone_NodeHealthCheckConfig_To_v1beta3_NodeHealthCheckConfig(in *kubeone.NodeHealthCheckConfig, out *NodeHealthCheckConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_NodeHealthCheckConfig_To_v1beta3_NodeHealthCheckConfig(in, out, s)
+}
+
 func autoConvert_v1beta3_NodeLocalDNS_To_kubeone_NodeLocalDNS(in *NodeLocalDNS, out *kubeone.NodeLocalDNS, s conversion.Scope) error {
 	out.Deploy = in.Deploy
 	return nil
@@ -2653,6 +2890,28 @@ func Convert_kubeone_PodNodeSelectorConfig_To_v1beta3_PodNodeSelectorConfig(in *
 	return autoConvert_kubeone_PodNodeSelectorConfig_To_v1beta3_PodNodeSelectorConfig(in, out, s)
 }
 
+func autoConvert_v1beta3_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(in *PrivilegeEscalation, out *kubeone.PrivilegeEscalation, s conversion.Scope) error {
+	out.Method = kubeone.PrivilegeEscalationMethod(in.Method)
+	out.Command = in.Command
+	return nil
+}
+
+// Convert_v1beta3_PrivilegeEscalation_To_kubeone_PrivilegeEscalation is an autogenerated conversion function.
+func Convert_v1beta3_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(in *PrivilegeEscalation, out *kubeone.PrivilegeEscalation, s conversion.Scope) error {
+	return autoConvert_v1beta3_PrivilegeEscalation_To_kubeone_PrivilegeEscalation(in, out, s)
+}
+
+func autoConvert_kubeone_PrivilegeEscalation_To_v1beta3_PrivilegeEscalation(in *kubeone.PrivilegeEscalation, out *PrivilegeEscalation, s conversion.Scope) error {
+	out.Method = PrivilegeEscalationMethod(in.Method)
+	out.Command = in.Command
+	return nil
+}
+
+// Convert_kubeone_PrivilegeEscalation_To_v1beta3_PrivilegeEscalation is an autogenerated conversion function.
+func Convert_kubeone_PrivilegeEscalation_To_v1beta3_PrivilegeEscalation(in *kubeone.PrivilegeEscalation, out *PrivilegeEscalation, s conversion.Scope) error {
+	return autoConvert_kubeone_PrivilegeEscalation_To_v1beta3_PrivilegeEscalation(in, out, s)
+}
+
 func autoConvert_v1beta3_ProviderSpec_To_kubeone_ProviderSpec(in *ProviderSpec, out *kubeone.ProviderSpec, s conversion.Scope) error {
 	out.CloudProviderSpec = *(*jsontext.Value)(unsafe.Pointer(&in.CloudProviderSpec))
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
@@ -2769,6 +3028,30 @@ func Convert_kubeone_RegistryConfiguration_To_v1beta3_RegistryConfiguration(in *
 	return autoConvert_kubeone_RegistryConfiguration_To_v1beta3_RegistryConfiguration(in, out, s)
 }
 
+func autoConvert_v1beta3_RetryPolicy_To_kubeone_RetryPolicy(in *RetryPolicy, out *kubeone.RetryPolicy, s conversion.Scope) error {
+	out.Attempts = in.Attempts
+	out.InitialBackOff = in.InitialBackOff
+	out.MaxBackOff = in.MaxBackOff
+	return nil
+}
+
+// Convert_v1beta3_RetryPolicy_To_kubeone_RetryPolicy is an autogenerated conversion function.
+func Convert_v1beta3_RetryPolicy_To_kubeone_RetryPolicy(in *RetryPolicy, out *kubeone.RetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta3_RetryPolicy_To_kubeone_RetryPolicy(in, out, s)
+}
+
+func autoConvert_kubeone_RetryPolicy_To_v1beta3_RetryPolicy(in *kubeone.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	out.Attempts = in.Attempts
+	out.InitialBackOff = in.InitialBackOff
+	out.MaxBackOff = in.MaxBackOff
+	return nil
+}
+
+// Convert_kubeone_RetryPolicy_To_v1beta3_RetryPolicy is an autogenerated conversion function.
+func Convert_kubeone_RetryPolicy_To_v1beta3_RetryPolicy(in *kubeone.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	return autoConvert_kubeone_RetryPolicy_To_v1beta3_RetryPolicy(in, out, s)
+}
+
 func autoConvert_v1beta3_SSHSpec_To_kubeone_SSHSpec(in *SSHSpec, out *kubeone.SSHSpec, s conversion.Scope) error {
 	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
 	out.Port = in.Port
@@ -2781,6 +3064,7 @@ func autoConvert_v1beta3_SSHSpec_To_kubeone_SSHSpec(in *SSHSpec, out *kubeone.SS
 	out.BastionPort = in.BastionPort
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
+	out.JumpHosts = *(*[]kubeone.JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	return nil
 }
 
@@ -2801,6 +3085,7 @@ func autoConvert_kubeone_SSHSpec_To_v1beta3_SSHSpec(in *kubeone.SSHSpec, out *SS
 	out.BastionPort = in.BastionPort
 	out.BastionUser = in.BastionUser
 	out.BastionHostPublicKey = *(*[]byte)(unsafe.Pointer(&in.BastionHostPublicKey))
+	out.JumpHosts = *(*[]JumpHost)(unsafe.Pointer(&in.JumpHosts))
 	return nil
 }
 
@@ -2865,6 +3150,9 @@ func Convert_kubeone_StaticAuditLogConfig_To_v1beta3_StaticAuditLogConfig(in *ku
 
 func autoConvert_v1beta3_StaticWorkersConfig_To_kubeone_StaticWorkersConfig(in *StaticWorkersConfig, out *kubeone.StaticWorkersConfig, s conversion.Scope) error {
 	out.Hosts = *(*[]kubeone.HostConfig)(unsafe.Pointer(&in.Hosts))
+	if err := Convert_v1beta3_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(&in.UpgradeStrategy, &out.UpgradeStrategy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2875,6 +3163,9 @@ func Convert_v1beta3_StaticWorkersConfig_To_kubeone_StaticWorkersConfig(in *Stat
 
 func autoConvert_kubeone_StaticWorkersConfig_To_v1beta3_StaticWorkersConfig(in *kubeone.StaticWorkersConfig, out *StaticWorkersConfig, s conversion.Scope) error {
 	out.Hosts = *(*[]HostConfig)(unsafe.Pointer(&in.Hosts))
+	if err := Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta3_StaticWorkersUpgradeStrategy(&in.UpgradeStrategy, &out.UpgradeStrategy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2883,6 +3174,30 @@ func Convert_kubeone_StaticWorkersConfig_To_v1beta3_StaticWorkersConfig(in *kube
 	return autoConvert_kubeone_StaticWorkersConfig_To_v1beta3_StaticWorkersConfig(in, out, s)
 }
 
+func autoConvert_v1beta3_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(in *StaticWorkersUpgradeStrategy, out *kubeone.StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.Canary = in.Canary
+	out.GroupByLabel = in.GroupByLabel
+	return nil
+}
+
+// Convert_v1beta3_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy is an autogenerated conversion function.
+func Convert_v1beta3_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(in *StaticWorkersUpgradeStrategy, out *kubeone.StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta3_StaticWorkersUpgradeStrategy_To_kubeone_StaticWorkersUpgradeStrategy(in, out, s)
+}
+
+func autoConvert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta3_StaticWorkersUpgradeStrategy(in *kubeone.StaticWorkersUpgradeStrategy, out *StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.Canary = in.Canary
+	out.GroupByLabel = in.GroupByLabel
+	return nil
+}
+
+// Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta3_StaticWorkersUpgradeStrategy is an autogenerated conversion function.
+func Convert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta3_StaticWorkersUpgradeStrategy(in *kubeone.StaticWorkersUpgradeStrategy, out *StaticWorkersUpgradeStrategy, s conversion.Scope) error {
+	return autoConvert_kubeone_StaticWorkersUpgradeStrategy_To_v1beta3_StaticWorkersUpgradeStrategy(in, out, s)
+}
+
 func autoConvert_v1beta3_SystemPackages_To_kubeone_SystemPackages(in *SystemPackages, out *kubeone.SystemPackages, s conversion.Scope) error {
 	out.ConfigureRepositories = in.ConfigureRepositories
 	return nil
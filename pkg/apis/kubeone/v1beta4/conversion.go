@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta4
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+
+	conversion "k8s.io/apimachinery/pkg/conversion"
+)
+
+func Convert_kubeone_KubeOneCluster_To_v1beta4_KubeOneCluster(in *kubeoneapi.KubeOneCluster, out *KubeOneCluster, scope conversion.Scope) error {
+	// AssetConfiguration has been removed in the v1beta2 API
+	if err := autoConvert_kubeone_KubeOneCluster_To_v1beta4_KubeOneCluster(in, out, scope); err != nil {
+		return err
+	}
+
+	// CABundle has been removed in the v1beta4 API in favor of CertificateAuthority.Bundle
+	if out.CertificateAuthority.Bundle == "" && out.CertificateAuthority.File == "" {
+		out.CertificateAuthority.Bundle = in.CABundle //nolint:staticcheck
+	}
+
+	// TLSCipherSuites is optional in the v1beta4 API
+	out.TLSCipherSuites = nil
+	tls := in.TLSCipherSuites
+	if len(tls.APIServer) == 0 && len(tls.Etcd) == 0 && len(tls.Kubelet) == 0 {
+		return nil
+	}
+
+	out.TLSCipherSuites = &TLSCipherSuites{}
+
+	return Convert_kubeone_TLSCipherSuites_To_v1beta4_TLSCipherSuites(&in.TLSCipherSuites, out.TLSCipherSuites, scope)
+}
+
+func Convert_v1beta4_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneCluster, out *kubeoneapi.KubeOneCluster, scope conversion.Scope) error {
+	if err := autoConvert_v1beta4_KubeOneCluster_To_kubeone_KubeOneCluster(in, out, scope); err != nil {
+		return err
+	}
+
+	// TLSCipherSuites is optional in the v1beta4 API
+	out.TLSCipherSuites = kubeoneapi.TLSCipherSuites{}
+	if in.TLSCipherSuites == nil {
+		return nil
+	}
+
+	return Convert_v1beta4_TLSCipherSuites_To_kubeone_TLSCipherSuites(in.TLSCipherSuites, &out.TLSCipherSuites, scope)
+}
+
+// Convert_kubeone_Addons_To_v1beta4_Addons splits the addons and the helm
+// releases, which are mixed in the internal API
+func Convert_kubeone_Addons_To_v1beta4_Addons(in *kubeoneapi.Addons, out *Addons, scope conversion.Scope) error {
+	if err := autoConvert_kubeone_Addons_To_v1beta4_Addons(in, out, scope); err != nil {
+		return err
+	}
+
+	out.Addons = nil
+	out.HelmReleases = nil
+
+	for _, ref := range in.Addons {
+		if ref.Addon != nil {
+			var addon Addon
+			if err := Convert_kubeone_Addon_To_v1beta4_Addon(ref.Addon, &addon, scope); err != nil {
+				return err
+			}
+			out.Addons = append(out.Addons, addon)
+		}
+
+		if ref.HelmRelease != nil {
+			var release HelmRelease
+			if err := Convert_kubeone_HelmRelease_To_v1beta4_HelmRelease(ref.HelmRelease, &release, scope); err != nil {
+				return err
+			}
+			out.HelmReleases = append(out.HelmReleases, release)
+		}
+	}
+
+	return nil
+}
+
+// Convert_v1beta4_Addons_To_kubeone_Addons merges the addons and the helm
+// releases, the addons go first. The order of the addons relative to the helm
+// releases isn't kept, which doesn't matter, as they're always applied
+// separately, see Addons.DeclaredAddonsOnly and Addons.OnlyHelmReleases.
+func Convert_v1beta4_Addons_To_kubeone_Addons(in *Addons, out *kubeoneapi.Addons, scope conversion.Scope) error {
+	if err := autoConvert_v1beta4_Addons_To_kubeone_Addons(in, out, scope); err != nil {
+		return err
+	}
+
+	out.Addons = nil
+
+	for i := range in.Addons {
+		addon := &kubeoneapi.Addon{}
+		if err := Convert_v1beta4_Addon_To_kubeone_Addon(&in.Addons[i], addon, scope); err != nil {
+			return err
+		}
+		out.Addons = append(out.Addons, kubeoneapi.AddonRef{Addon: addon})
+	}
+
+	for i := range in.HelmReleases {
+		release := &kubeoneapi.HelmRelease{}
+		if err := Convert_v1beta4_HelmRelease_To_kubeone_HelmRelease(&in.HelmReleases[i], release, scope); err != nil {
+			return err
+		}
+		out.Addons = append(out.Addons, kubeoneapi.AddonRef{HelmRelease: release})
+	}
+
+	return nil
+}
+
+func Convert_v1beta4_ContainerRuntimeConfig_To_kubeone_ContainerRuntimeConfig(in *ContainerRuntimeConfig, out *kubeoneapi.ContainerRuntimeConfig, scope conversion.Scope) error {
+	return autoConvert_v1beta4_ContainerRuntimeConfig_To_kubeone_ContainerRuntimeConfig(in, out, scope)
+}
+
+func Convert_v1beta4_CiliumSpec_To_kubeone_CiliumSpec(in *CiliumSpec, out *kubeoneapi.CiliumSpec, _ conversion.Scope) error {
+	out.KubeProxyReplacement = in.KubeProxyReplacement
+	out.EnableHubble = in.EnableHubble
+	out.EnableL2Announcements = in.EnableL2Announcements
+	out.EnableGatewayAPI = in.EnableGatewayAPI
+	out.EnableLocalRedirectPolicy = in.EnableLocalRedirectPolicy
+
+	return nil
+}
+
+func Convert_kubeone_CiliumSpec_To_v1beta4_CiliumSpec(in *kubeoneapi.CiliumSpec, out *CiliumSpec, s conversion.Scope) error {
+	out.KubeProxyReplacement = in.KubeProxyReplacement
+
+	return autoConvert_kubeone_CiliumSpec_To_v1beta4_CiliumSpec(in, out, s)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta4
+
+import (
+	"reflect"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+func TestConvertKubeOneClusterTLSCipherSuites(t *testing.T) {
+	tests := []struct {
+		name string
+		in   kubeoneapi.TLSCipherSuites
+		want *TLSCipherSuites
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "etcd only",
+			in:   kubeoneapi.TLSCipherSuites{Etcd: []string{"TLS_AES_128_GCM_SHA256"}},
+			want: &TLSCipherSuites{Etcd: []string{"TLS_AES_128_GCM_SHA256"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := &kubeoneapi.KubeOneCluster{TLSCipherSuites: tt.in}
+			out := &KubeOneCluster{}
+
+			if err := Convert_kubeone_KubeOneCluster_To_v1beta4_KubeOneCluster(in, out, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(out.TLSCipherSuites, tt.want) {
+				t.Errorf("TLSCipherSuites = %+v, want %+v", out.TLSCipherSuites, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertAddonsRoundTrip(t *testing.T) {
+	in := &kubeoneapi.Addons{
+		Addons: []kubeoneapi.AddonRef{
+			{Addon: &kubeoneapi.Addon{Name: "first"}},
+			{HelmRelease: &kubeoneapi.HelmRelease{Chart: "one"}},
+			{Addon: &kubeoneapi.Addon{Name: "second"}},
+			{HelmRelease: &kubeoneapi.HelmRelease{Chart: "two"}},
+		},
+	}
+
+	versioned := &Addons{}
+	if err := Convert_kubeone_Addons_To_v1beta4_Addons(in, versioned, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	out := &kubeoneapi.Addons{}
+	if err := Convert_v1beta4_Addons_To_kubeone_Addons(versioned, out, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// the addons and the helm releases are applied separately, so only their
+	// own order matters
+	if got, want := out.DeclaredAddonsOnly(), in.DeclaredAddonsOnly(); !reflect.DeepEqual(got, want) {
+		t.Errorf("addons = %+v, want %+v", got, want)
+	}
+	if got, want := out.OnlyHelmReleases(), in.OnlyHelmReleases(); !reflect.DeepEqual(got, want) {
+		t.Errorf("helm releases = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:defaulter-gen=TypeMeta
+// +groupName=kubeone.k8c.io
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=k8c.io/kubeone/pkg/apis/kubeone
+
+// Package v1beta4 defines the v1beta4 version of KubeOneCluster API
+package v1beta4
@@ -33,6 +33,8 @@ import (
 	helm "k8c.io/kubeone/pkg/localhelm"
 	"k8c.io/kubeone/pkg/semverutil"
 
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	netutils "k8s.io/utils/net"
@@ -87,6 +89,8 @@ func ValidateKubeOneCluster(c kubeoneapi.KubeOneCluster) field.ErrorList {
 	allErrs = append(allErrs, ValidateAddons(c.Addons, field.NewPath("addons"))...)
 	allErrs = append(allErrs, ValidateRegistryConfiguration(c.RegistryConfiguration, field.NewPath("registryConfiguration"))...)
 	allErrs = append(allErrs, ValidateControlPlaneComponents(c.ControlPlaneComponents, field.NewPath("controlPlaneComponents"))...)
+	allErrs = append(allErrs, ValidateExecutionConfig(c.Execution, field.NewPath("execution"))...)
+	allErrs = append(allErrs, ValidateHooks(c.Hooks, field.NewPath("hooks"))...)
 
 	return allErrs
 }
@@ -677,6 +681,8 @@ func ValidateStaticWorkersConfig(staticWorkers kubeoneapi.StaticWorkersConfig, c
 		allErrs = append(allErrs, ValidateHostConfig(staticWorkers.Hosts, clusterNetwork, fldPath.Child("hosts"))...)
 	}
 
+	allErrs = append(allErrs, ValidateStaticWorkersUpgradeStrategy(staticWorkers.UpgradeStrategy, fldPath.Child("upgradeStrategy"))...)
+
 	for idx, worker := range staticWorkers.Hosts {
 		for _, cp := range controlPlane.Hosts {
 			if cp.Hostname != "" && worker.Hostname != "" && cp.Hostname == worker.Hostname {
@@ -717,6 +723,31 @@ func ValidateStaticWorkersConfig(staticWorkers kubeoneapi.StaticWorkersConfig, c
 	return allErrs
 }
 
+// ValidateStaticWorkersUpgradeStrategy validates the StaticWorkersUpgradeStrategy structure
+func ValidateStaticWorkersUpgradeStrategy(strategy kubeoneapi.StaticWorkersUpgradeStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strategy.MaxUnavailable != nil {
+		maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(strategy.MaxUnavailable, 100, false)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), err.Error()))
+		case maxUnavailable < 1:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), "maxUnavailable must be at least 1 or 1%"))
+		case strategy.MaxUnavailable.Type == intstr.String && maxUnavailable > 100:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), "maxUnavailable percentage can't be more than 100%"))
+		}
+	}
+
+	if strategy.GroupByLabel != "" {
+		for _, msg := range validation.IsQualifiedName(strategy.GroupByLabel) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("groupByLabel"), strategy.GroupByLabel, msg))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateDynamicWorkerConfig validates the DynamicWorkerConfig structure
 func ValidateDynamicWorkerConfig(workerset []kubeoneapi.DynamicWorkerConfig, prov kubeoneapi.CloudProviderSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -962,6 +993,11 @@ func ValidateHostConfig(hosts []kubeoneapi.HostConfig, clusterNetwork kubeoneapi
 		if !host.OperatingSystem.IsValid() {
 			allErrs = append(allErrs, field.Invalid(hostFldPath.Child("operatingSystem"), host.OperatingSystem, "invalid operatingSystem provided"))
 		}
+		if len(host.JumpHosts) > 0 && host.Bastion != "" {
+			allErrs = append(allErrs, field.Forbidden(hostFldPath.Child("jumpHosts"), "jumpHosts and bastion are mutually exclusive"))
+		}
+		allErrs = append(allErrs, validateJumpHosts(host.JumpHosts, hostFldPath.Child("jumpHosts"))...)
+		allErrs = append(allErrs, validatePrivilegeEscalation(host.PrivilegeEscalation, hostFldPath.Child("privilegeEscalation"))...)
 		allErrs = append(allErrs, ValidateKubeletConfig(host.Kubelet, hostFldPath.Child("kubelet"))...)
 		allErrs = append(allErrs, validateLabels(host.Annotations, hostFldPath.Child("annotations"))...)
 		allErrs = append(allErrs, validateLabels(host.Labels, hostFldPath.Child("labels"))...)
@@ -975,6 +1011,45 @@ func ValidateHostConfig(hosts []kubeoneapi.HostConfig, clusterNetwork kubeoneapi
 	return allErrs
 }
 
+func validateJumpHosts(jumpHosts []kubeoneapi.JumpHost, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for idx, jumpHost := range jumpHosts {
+		if jumpHost.Address == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(idx).Child("address"), "no jump host address given"))
+		}
+		if jumpHost.Port < 0 || jumpHost.Port > 65535 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(idx).Child("port"), jumpHost.Port, "port must be between 1 and 65535"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePrivilegeEscalation(pe kubeoneapi.PrivilegeEscalation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch pe.Method {
+	case "", kubeoneapi.PrivilegeEscalationSudo, kubeoneapi.PrivilegeEscalationDoas, kubeoneapi.PrivilegeEscalationNone:
+		if pe.Command != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("command"), "command is only allowed with the custom method"))
+		}
+	case kubeoneapi.PrivilegeEscalationCustom:
+		if strings.TrimSpace(pe.Command) == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("command"), "command is required with the custom method"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("method"), pe.Method, []string{
+			string(kubeoneapi.PrivilegeEscalationSudo),
+			string(kubeoneapi.PrivilegeEscalationDoas),
+			string(kubeoneapi.PrivilegeEscalationNone),
+			string(kubeoneapi.PrivilegeEscalationCustom),
+		}))
+	}
+
+	return allErrs
+}
+
 func validateLabels(kv map[string]string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1031,6 +1106,88 @@ func ValidateControlPlaneComponents(c *kubeoneapi.ControlPlaneComponents, fldPat
 	return allErrs
 }
 
+func ValidateExecutionConfig(e kubeoneapi.ExecutionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if e.Concurrency < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrency"), e.Concurrency, "concurrency can't be negative"))
+	}
+
+	allErrs = append(allErrs, ValidateRetryPolicy(e.Retry, fldPath.Child("retry"))...)
+
+	for operation, policy := range e.OperationRetry {
+		allErrs = append(allErrs, ValidateRetryPolicy(policy, fldPath.Child("operationRetry").Key(operation))...)
+	}
+
+	allErrs = append(allErrs, ValidateNodeHealthCheckConfig(e.NodeHealthCheck, fldPath.Child("nodeHealthCheck"))...)
+
+	return allErrs
+}
+
+func ValidateNodeHealthCheckConfig(h kubeoneapi.NodeHealthCheckConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if h.Timeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), h.Timeout.String(), "timeout can't be negative"))
+	}
+
+	for idx, selector := range h.PodSelectors {
+		if _, err := labels.Parse(selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("podSelectors").Index(idx), selector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateHooks(h kubeoneapi.Hooks, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateHookList(h.BeforeDrain, fldPath.Child("beforeDrain"))...)
+	allErrs = append(allErrs, validateHookList(h.AfterUpgrade, fldPath.Child("afterUpgrade"))...)
+	allErrs = append(allErrs, validateHookList(h.AfterJoin, fldPath.Child("afterJoin"))...)
+	allErrs = append(allErrs, validateHookList(h.BeforeReset, fldPath.Child("beforeReset"))...)
+
+	return allErrs
+}
+
+func validateHookList(hooks []kubeoneapi.Hook, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for idx, hook := range hooks {
+		if strings.TrimSpace(hook.Command) == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(idx).Child("command"), "command is required"))
+		}
+
+		switch hook.FailurePolicy {
+		case "", kubeoneapi.HookFailurePolicyAbort, kubeoneapi.HookFailurePolicyIgnore:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(idx).Child("failurePolicy"), hook.FailurePolicy, []string{
+				string(kubeoneapi.HookFailurePolicyAbort),
+				string(kubeoneapi.HookFailurePolicyIgnore),
+			}))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateRetryPolicy(r kubeoneapi.RetryPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if r.Attempts < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("attempts"), r.Attempts, "attempts can't be negative"))
+	}
+	if r.InitialBackOff.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialBackOff"), r.InitialBackOff.String(), "initialBackOff can't be negative"))
+	}
+	if r.MaxBackOff.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackOff"), r.MaxBackOff.String(), "maxBackOff can't be negative"))
+	}
+
+	return allErrs
+}
+
 func ValidateEtcdConfig(etcdConf *kubeoneapi.EtcdConfig, fldPath *field.Path, allErrs field.ErrorList) field.ErrorList {
 	if etcdConf == nil {
 		return allErrs
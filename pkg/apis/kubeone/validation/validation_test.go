@@ -18,6 +18,7 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/google/go-cmp/cmp"
@@ -25,7 +26,10 @@ import (
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 )
 
 func TestValidateKubeOneCluster(t *testing.T) {
@@ -1837,6 +1841,72 @@ func TestValidateStaticWorkersConfig(t *testing.T) {
 	}
 }
 
+func TestValidateStaticWorkersUpgradeStrategy(t *testing.T) {
+	tests := []struct {
+		name          string
+		strategy      kubeoneapi.StaticWorkersUpgradeStrategy
+		expectedError bool
+	}{
+		{
+			name:          "valid upgrade strategy (empty)",
+			strategy:      kubeoneapi.StaticWorkersUpgradeStrategy{},
+			expectedError: false,
+		},
+		{
+			name: "valid upgrade strategy (count)",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromInt32(5)),
+				Canary:         true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid upgrade strategy (percentage and label)",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromString("25%")),
+				GroupByLabel:   "topology.kubernetes.io/zone",
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid upgrade strategy (zero maxUnavailable)",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid upgrade strategy (maxUnavailable is not a percentage)",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromString("five")),
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid upgrade strategy (maxUnavailable over 100%)",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromString("150%")),
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid upgrade strategy (label key)",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				GroupByLabel: "not a label",
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateStaticWorkersUpgradeStrategy(tc.strategy, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
 func TestValidateDynamicWorkerConfig(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -2799,6 +2869,118 @@ func TestValidateHostConfig(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "jump hosts",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHUsername:       "root",
+					JumpHosts: []kubeoneapi.JumpHost{
+						{Address: "jump1.example.com"},
+						{Address: "10.0.0.1", Port: 2222, User: "jump"},
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "jump host without address",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHUsername:       "root",
+					JumpHosts: []kubeoneapi.JumpHost{
+						{Port: 22},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "jump hosts together with bastion",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHUsername:       "root",
+					Bastion:           "bastion.example.com",
+					JumpHosts: []kubeoneapi.JumpHost{
+						{Address: "jump1.example.com"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "doas privilege escalation",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:       "192.168.1.1",
+					PrivateAddress:      "192.168.0.1",
+					SSHPrivateKeyFile:   "test",
+					SSHUsername:         "root",
+					PrivilegeEscalation: kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationDoas},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "custom privilege escalation",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:       "192.168.1.1",
+					PrivateAddress:      "192.168.0.1",
+					SSHPrivateKeyFile:   "test",
+					SSHUsername:         "root",
+					PrivilegeEscalation: kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationCustom, Command: "sudo -u admin"},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "custom privilege escalation without command",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:       "192.168.1.1",
+					PrivateAddress:      "192.168.0.1",
+					SSHPrivateKeyFile:   "test",
+					SSHUsername:         "root",
+					PrivilegeEscalation: kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationCustom},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "command without custom privilege escalation",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:       "192.168.1.1",
+					PrivateAddress:      "192.168.0.1",
+					SSHPrivateKeyFile:   "test",
+					SSHUsername:         "root",
+					PrivilegeEscalation: kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationNone, Command: "pfexec"},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "unknown privilege escalation",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:       "192.168.1.1",
+					PrivateAddress:      "192.168.0.1",
+					SSHPrivateKeyFile:   "test",
+					SSHUsername:         "root",
+					PrivilegeEscalation: kubeoneapi.PrivilegeEscalation{Method: "su"},
+				},
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -2946,6 +3128,145 @@ func TestValidateRegistryConfiguration(t *testing.T) {
 	}
 }
 
+func TestValidateExecutionConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		execution     kubeoneapi.ExecutionConfig
+		expectedError bool
+	}{
+		{
+			name:          "valid execution config (empty)",
+			execution:     kubeoneapi.ExecutionConfig{},
+			expectedError: false,
+		},
+		{
+			name: "valid execution config (concurrency)",
+			execution: kubeoneapi.ExecutionConfig{
+				Concurrency: 10,
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid execution config (negative concurrency)",
+			execution: kubeoneapi.ExecutionConfig{
+				Concurrency: -1,
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid execution config (retry policies)",
+			execution: kubeoneapi.ExecutionConfig{
+				Retry: kubeoneapi.RetryPolicy{
+					Attempts:       3,
+					InitialBackOff: metav1.Duration{Duration: 5 * time.Second},
+					MaxBackOff:     metav1.Duration{Duration: time.Minute},
+				},
+				OperationRetry: map[string]kubeoneapi.RetryPolicy{
+					"applying addons": {Attempts: 1},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid execution config (negative attempts)",
+			execution: kubeoneapi.ExecutionConfig{
+				Retry: kubeoneapi.RetryPolicy{
+					Attempts: -1,
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid execution config (node health check)",
+			execution: kubeoneapi.ExecutionConfig{
+				NodeHealthCheck: kubeoneapi.NodeHealthCheckConfig{
+					Timeout:      metav1.Duration{Duration: 10 * time.Minute},
+					PodSelectors: []string{"app.kubernetes.io/name=ingress-nginx", "tier in (frontend)"},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid execution config (node health check selector)",
+			execution: kubeoneapi.ExecutionConfig{
+				NodeHealthCheck: kubeoneapi.NodeHealthCheckConfig{
+					PodSelectors: []string{"app in ingress"},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid execution config (negative operation backoff)",
+			execution: kubeoneapi.ExecutionConfig{
+				OperationRetry: map[string]kubeoneapi.RetryPolicy{
+					"applying addons": {InitialBackOff: metav1.Duration{Duration: -time.Second}},
+				},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateExecutionConfig(tc.execution, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateHooks(t *testing.T) {
+	tests := []struct {
+		name          string
+		hooks         kubeoneapi.Hooks
+		expectedError bool
+	}{
+		{
+			name:          "valid hooks (empty)",
+			hooks:         kubeoneapi.Hooks{},
+			expectedError: false,
+		},
+		{
+			name: "valid hooks",
+			hooks: kubeoneapi.Hooks{
+				BeforeDrain: []kubeoneapi.Hook{
+					{Name: "notify", Command: "./notify.sh", Local: true, FailurePolicy: kubeoneapi.HookFailurePolicyIgnore},
+				},
+				AfterJoin: []kubeoneapi.Hook{
+					{Command: "systemctl restart node-agent", FailurePolicy: kubeoneapi.HookFailurePolicyAbort},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid hooks (no command)",
+			hooks: kubeoneapi.Hooks{
+				BeforeReset: []kubeoneapi.Hook{
+					{Name: "backup"},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid hooks (unknown failure policy)",
+			hooks: kubeoneapi.Hooks{
+				AfterUpgrade: []kubeoneapi.Hook{
+					{Command: "./check.sh", FailurePolicy: "Retry"},
+				},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateHooks(tc.hooks, field.NewPath("hooks"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
 func TestValidateAssetConfiguration(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -27,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -806,6 +807,31 @@ func (in *EventRateLimitConfig) DeepCopy() *EventRateLimitConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionConfig) DeepCopyInto(out *ExecutionConfig) {
+	*out = *in
+	out.Retry = in.Retry
+	if in.OperationRetry != nil {
+		in, out := &in.OperationRetry, &out.OperationRetry
+		*out = make(map[string]RetryPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.NodeHealthCheck.DeepCopyInto(&out.NodeHealthCheck)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionConfig.
+func (in *ExecutionConfig) DeepCopy() *ExecutionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalCNISpec) DeepCopyInto(out *ExternalCNISpec) {
 	*out = *in
@@ -1041,6 +1067,58 @@ func (in *HetznerSpec) DeepCopy() *HetznerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hook) DeepCopyInto(out *Hook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
+func (in *Hook) DeepCopy() *Hook {
+	if in == nil {
+		return nil
+	}
+	out := new(Hook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hooks) DeepCopyInto(out *Hooks) {
+	*out = *in
+	if in.BeforeDrain != nil {
+		in, out := &in.BeforeDrain, &out.BeforeDrain
+		*out = make([]Hook, len(*in))
+		copy(*out, *in)
+	}
+	if in.AfterUpgrade != nil {
+		in, out := &in.AfterUpgrade, &out.AfterUpgrade
+		*out = make([]Hook, len(*in))
+		copy(*out, *in)
+	}
+	if in.AfterJoin != nil {
+		in, out := &in.AfterJoin, &out.AfterJoin
+		*out = make([]Hook, len(*in))
+		copy(*out, *in)
+	}
+	if in.BeforeReset != nil {
+		in, out := &in.BeforeReset, &out.BeforeReset
+		*out = make([]Hook, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hooks.
+func (in *Hooks) DeepCopy() *Hooks {
+	if in == nil {
+		return nil
+	}
+	out := new(Hooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostConfig) DeepCopyInto(out *HostConfig) {
 	*out = *in
@@ -1059,6 +1137,13 @@ func (in *HostConfig) DeepCopyInto(out *HostConfig) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.JumpHosts != nil {
+		in, out := &in.JumpHosts, &out.JumpHosts
+		*out = make([]JumpHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
 		*out = make([]corev1.Taint, len(*in))
@@ -1081,6 +1166,7 @@ func (in *HostConfig) DeepCopyInto(out *HostConfig) {
 		}
 	}
 	in.Kubelet.DeepCopyInto(&out.Kubelet)
+	out.PrivilegeEscalation = in.PrivilegeEscalation
 	return
 }
 
@@ -1150,6 +1236,27 @@ func (in *ImageAsset) DeepCopy() *ImageAsset {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JumpHost) DeepCopyInto(out *JumpHost) {
+	*out = *in
+	if in.HostPublicKey != nil {
+		in, out := &in.HostPublicKey, &out.HostPublicKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JumpHost.
+func (in *JumpHost) DeepCopy() *JumpHost {
+	if in == nil {
+		return nil
+	}
+	out := new(JumpHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeOneCluster) DeepCopyInto(out *KubeOneCluster) {
 	*out = *in
@@ -1205,6 +1312,8 @@ func (in *KubeOneCluster) DeepCopyInto(out *KubeOneCluster) {
 		*out = new(ControlPlaneComponents)
 		(*in).DeepCopyInto(*out)
 	}
+	in.Execution.DeepCopyInto(&out.Execution)
+	in.Hooks.DeepCopyInto(&out.Hooks)
 	return
 }
 
@@ -1420,6 +1529,28 @@ func (in *MetricsServer) DeepCopy() *MetricsServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheckConfig) DeepCopyInto(out *NodeHealthCheckConfig) {
+	*out = *in
+	out.Timeout = in.Timeout
+	if in.PodSelectors != nil {
+		in, out := &in.PodSelectors, &out.PodSelectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckConfig.
+func (in *NodeHealthCheckConfig) DeepCopy() *NodeHealthCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
 	*out = *in
@@ -1681,6 +1812,22 @@ func (in *PodNodeSelectorConfig) DeepCopy() *PodNodeSelectorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivilegeEscalation) DeepCopyInto(out *PrivilegeEscalation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivilegeEscalation.
+func (in *PrivilegeEscalation) DeepCopy() *PrivilegeEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivilegeEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderSpec) DeepCopyInto(out *ProviderSpec) {
 	*out = *in
@@ -1806,6 +1953,24 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	out.InitialBackOff = in.InitialBackOff
+	out.MaxBackOff = in.MaxBackOff
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHSpec) DeepCopyInto(out *SSHSpec) {
 	*out = *in
@@ -1824,6 +1989,13 @@ func (in *SSHSpec) DeepCopyInto(out *SSHSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.JumpHosts != nil {
+		in, out := &in.JumpHosts, &out.JumpHosts
+		*out = make([]JumpHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1880,6 +2052,7 @@ func (in *StaticWorkersConfig) DeepCopyInto(out *StaticWorkersConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.UpgradeStrategy.DeepCopyInto(&out.UpgradeStrategy)
 	return
 }
 
@@ -1893,6 +2066,27 @@ func (in *StaticWorkersConfig) DeepCopy() *StaticWorkersConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticWorkersUpgradeStrategy) DeepCopyInto(out *StaticWorkersUpgradeStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticWorkersUpgradeStrategy.
+func (in *StaticWorkersUpgradeStrategy) DeepCopy() *StaticWorkersUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticWorkersUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemPackages) DeepCopyInto(out *SystemPackages) {
 	*out = *in
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit implements the audit log of the commands run on the hosts,
+// appended as JSON lines to the local file with the secrets redacted.
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// APIVersion is the version of the audit log format. Fields are only ever
+// added within the same version, removing or changing the meaning of a field
+// requires a new version.
+const APIVersion = "audit.kubeone.k8c.io/v1"
+
+// redacted replaces the secrets in the audit log
+const redacted = "[REDACTED]"
+
+// Record is a single line of the audit log
+type Record struct {
+	APIVersion string `json:"apiVersion"`
+	// Host is the address of the host the command has been run on
+	Host string `json:"host"`
+	// Hostname is the hostname of the host, if known
+	Hostname string `json:"hostname,omitempty"`
+	// Command is the rendered script, with the secrets redacted
+	Command   string    `json:"command"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	ExitCode  int       `json:"exitCode"`
+	// StdinBytes, StdoutBytes and StderrBytes are the sizes of the input and
+	// the output of the command
+	StdinBytes  int64 `json:"stdinBytes"`
+	StdoutBytes int64 `json:"stdoutBytes"`
+	StderrBytes int64 `json:"stderrBytes"`
+	// Error is the error of the command, with the secrets redacted
+	Error string `json:"error,omitempty"`
+}
+
+// Log appends the records to the audit log. A nil *Log is valid and discards
+// all records.
+type Log struct {
+	lock sync.Mutex
+	enc  *json.Encoder
+	now  func() time.Time
+}
+
+// New creates the log writing to the given writer
+func New(w io.Writer) *Log {
+	return &Log{
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+}
+
+// Open creates the log appending to the given file. The file is created,
+// readable only by the owner, if it doesn't exist, and is never truncated.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fail.Runtime(err, "opening audit log")
+	}
+
+	return New(f), nil
+}
+
+// Write redacts the secrets and appends the record to the log. Failing to
+// write the record doesn't fail the command.
+func (l *Log) Write(rec Record) {
+	if l == nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	rec.APIVersion = APIVersion
+	rec.StartTime = rec.StartTime.UTC()
+	rec.EndTime = rec.EndTime.UTC()
+	rec.Command = Redact(rec.Command)
+	rec.Error = Redact(rec.Error)
+
+	_ = l.enc.Encode(rec)
+}
+
+var secretPatterns = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	{
+		// kubeadm bootstrap tokens
+		re:   regexp.MustCompile(`\b[a-z0-9]{6}\.[a-z0-9]{16}\b`),
+		repl: redacted,
+	},
+	{
+		// the secret command line flags, e.g. --token abc or --certificate-key=abc
+		re:   regexp.MustCompile(`(?i)(--(?:token|certificate-key|password|passwd|[a-z-]*secret)[= ]+)('[^']*'|"[^"]*"|\S+)`),
+		repl: "${1}" + redacted,
+	},
+	{
+		// the secret keys of the YAML, TOML, JSON and environment variables,
+		// e.g. password = "abc" in the containerd registry config
+		re:   regexp.MustCompile(`(?i)((?:password|passwd|token|secret|auth|identitytoken|certificatekey|certificate_key)["']?\s*[:=]\s*)('[^']*'|"[^"]*"|\S+)`),
+		repl: "${1}" + redacted,
+	},
+	{
+		// the HTTP authorization headers, e.g. of curl
+		re:   regexp.MustCompile(`(?i)(authorization:\s*(?:bearer|basic)\s+)[^\s'"]+`),
+		repl: "${1}" + redacted,
+	},
+	{
+		// the passwords in the URLs, e.g. of the proxies
+		re:   regexp.MustCompile(`(://[^:/@\s]+:)[^@\s]+@`),
+		repl: "${1}" + redacted + "@",
+	},
+}
+
+// Redact replaces the join tokens, the certificate keys, the passwords and
+// other secrets in the command or its output
+func Redact(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.re.ReplaceAllString(s, pattern.repl)
+	}
+
+	return s
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/executor/executorfs"
+)
+
+// errSFTPNotAudited refuses the SFTP server, the commands of the fallback are
+// audited instead
+var errSFTPNotAudited = errors.New("the SFTP server is disabled while auditing")
+
+var (
+	_ executor.Adapter       = &adapter{}
+	_ executor.ContextOpener = &adapter{}
+	_ executor.Interface     = conn{}
+)
+
+// WrapAdapter returns the executor adapter recording every command run over
+// its connections to the log. Both SSH and local adapters are supported.
+//
+// The SFTP server isn't started over the connections, as the file transfers
+// would bypass the log, so executorfs falls back to the shell commands, which
+// are recorded.
+func WrapAdapter(inner executor.Adapter, log *Log) executor.Adapter {
+	if log == nil {
+		return inner
+	}
+
+	return &adapter{inner: inner, log: log}
+}
+
+type adapter struct {
+	inner executor.Adapter
+	log   *Log
+}
+
+func (a *adapter) Open(host kubeoneapi.HostConfig) (executor.Interface, error) {
+	inner, err := a.inner.Open(host)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.conn(inner, host), nil
+}
+
+func (a *adapter) OpenContext(ctx context.Context, host kubeoneapi.HostConfig) (executor.Interface, error) {
+	inner, err := executor.Open(ctx, a.inner, host)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.conn(inner, host), nil
+}
+
+func (a *adapter) conn(inner executor.Interface, host kubeoneapi.HostConfig) conn {
+	return conn{
+		inner:    inner,
+		log:      a.log,
+		host:     hostAddress(host),
+		hostname: host.Hostname,
+	}
+}
+
+// Tunnel doesn't run any commands on the host
+func (a *adapter) Tunnel(host kubeoneapi.HostConfig) (executor.Tunneler, error) {
+	return a.inner.Tunnel(host)
+}
+
+func hostAddress(host kubeoneapi.HostConfig) string {
+	if host.PublicAddress != "" {
+		return host.PublicAddress
+	}
+
+	return host.PrivateAddress
+}
+
+// conn is the value, so the connections returned for the same cached
+// connection of the inner adapter are equal, which keeps the per-connection
+// state of executorfs shared between them
+type conn struct {
+	inner    executor.Interface
+	log      *Log
+	host     string
+	hostname string
+}
+
+func (c conn) Exec(cmd string) (string, string, int, error) {
+	start := c.log.now()
+	stdout, stderr, exitCode, err := c.inner.Exec(cmd)
+
+	c.record(Record{
+		Command:     cmd,
+		StartTime:   start,
+		ExitCode:    exitCode,
+		StdoutBytes: int64(len(stdout)),
+		StderrBytes: int64(len(stderr)),
+	}, err)
+
+	return stdout, stderr, exitCode, err
+}
+
+func (c conn) POpen(cmd string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	if executorfs.IsSFTPServerCommand(cmd) {
+		c.record(Record{Command: cmd, StartTime: c.log.now(), ExitCode: 127}, errSFTPNotAudited)
+
+		return 127, errSFTPNotAudited
+	}
+
+	var stdinCounter *countingReader
+	if stdin != nil {
+		stdinCounter = &countingReader{Reader: stdin}
+		stdin = stdinCounter
+	}
+
+	stdoutCounter := &countingWriter{Writer: stdout}
+	stderrCounter := &countingWriter{Writer: stderr}
+
+	start := c.log.now()
+	exitCode, err := c.inner.POpen(cmd, stdin, stdoutCounter, stderrCounter)
+
+	rec := Record{
+		Command:     cmd,
+		StartTime:   start,
+		ExitCode:    exitCode,
+		StdoutBytes: stdoutCounter.n.Load(),
+		StderrBytes: stderrCounter.n.Load(),
+	}
+	if stdinCounter != nil {
+		rec.StdinBytes = stdinCounter.n.Load()
+	}
+	c.record(rec, err)
+
+	return exitCode, err
+}
+
+func (c conn) Close() error {
+	return c.inner.Close()
+}
+
+func (c conn) record(rec Record, err error) {
+	rec.Host = c.host
+	rec.Hostname = c.hostname
+	rec.EndTime = c.log.now()
+
+	if err != nil {
+		rec.Error = err.Error()
+	}
+
+	c.log.Write(rec)
+}
+
+// countingReader counts the bytes read, the counter is atomic as the streams
+// are copied in the background by the SSH session
+type countingReader struct {
+	io.Reader
+	n atomic.Int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n.Add(int64(n))
+
+	return n, err
+}
+
+type countingWriter struct {
+	io.Writer
+	n atomic.Int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	if w.Writer == nil {
+		w.n.Add(int64(len(p)))
+
+		return len(p), nil
+	}
+
+	n, err := w.Writer.Write(p)
+	w.n.Add(int64(n))
+
+	return n, err
+}
@@ -447,6 +447,7 @@ func hostConfigsFromKubevirtMachines(machines []provisioner.Machine, nodeSets []
 				BastionPort:          nodeSet.SSH.BastionPort,
 				BastionUser:          nodeSet.SSH.BastionUser,
 				BastionHostPublicKey: nodeSet.SSH.BastionHostPublicKey,
+				JumpHosts:            nodeSet.SSH.JumpHosts,
 				OperatingSystem:      nodeSet.OperatingSystem,
 				Labels:               nodeSet.NodeSettings.Labels,
 				Annotations:          nodeSet.NodeSettings.Annotations,
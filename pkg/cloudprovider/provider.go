@@ -86,6 +86,7 @@ func HostConfigsFromMachines(machines []provisioner.Machine, nodeSets []kubeonea
 				BastionPort:          nodeSet.SSH.BastionPort,
 				BastionUser:          nodeSet.SSH.BastionUser,
 				BastionHostPublicKey: nodeSet.SSH.BastionHostPublicKey,
+				JumpHosts:            nodeSet.SSH.JumpHosts,
 				OperatingSystem:      nodeSet.OperatingSystem,
 				Labels:               nodeSet.NodeSettings.Labels,
 				Annotations:          nodeSet.NodeSettings.Annotations,
@@ -17,23 +17,22 @@ limitations under the License.
 package cmd
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
-	"reflect"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/Masterminds/semver/v3"
-	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
-	"k8c.io/kubeone/pkg/clusterstatus/etcdstatus"
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/confirmation"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
 	"k8c.io/kubeone/pkg/tasks"
-
-	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
-	kyaml "sigs.k8s.io/yaml"
 )
 
 type applyOpts struct {
@@ -49,6 +48,14 @@ type applyOpts struct {
 	PruneImages               bool `longflag:"prune-images"`
 	CreateMachineDeployments  bool `longflag:"create-machine-deployments"`
 	RotateEncryptionKey       bool `longflag:"rotate-encryption-key"`
+	SkipEtcdSnapshot          bool `longflag:"skip-etcd-snapshot"`
+	EtcdSnapshotRetention     int  `longflag:"etcd-snapshot-retention"`
+	// Phase selection flags
+	OnlyPhases []string `longflag:"only"`
+	SkipPhases []string `longflag:"skip"`
+	// Plan flags
+	PlanFile string `longflag:"plan"`
+	Resume   bool   `longflag:"resume"`
 }
 
 func (opts *applyOpts) BuildState() (*state.State, error) {
@@ -58,13 +65,26 @@ func (opts *applyOpts) BuildState() (*state.State, error) {
 	}
 
 	s.BackupFile = defaultBackupPath(opts.BackupFile, opts.ManifestFile, s.Cluster.Name)
+	opts.applyToState(s)
+
+	return s, initBackup(s.BackupFile)
+}
+
+func (opts *applyOpts) applyToState(s *state.State) {
 	s.ForceInstall = opts.ForceInstall
 	s.ForceUpgrade = opts.ForceUpgrade
 	s.UpgradeMachineDeployments = opts.UpgradeMachineDeployments
 	s.PruneImages = opts.PruneImages
 	s.CreateMachineDeployments = opts.CreateMachineDeployments
+	s.SkipEtcdSnapshot = opts.SkipEtcdSnapshot
+	s.EtcdSnapshotRetention = opts.EtcdSnapshotRetention
+}
 
-	return s, initBackup(s.BackupFile)
+func (opts *applyOpts) phaseFilter() tasks.PhaseFilter {
+	return tasks.PhaseFilter{
+		Only: opts.OnlyPhases,
+		Skip: opts.SkipPhases,
+	}
 }
 
 func applyCmd(rootFlags *pflag.FlagSet) *cobra.Command {
@@ -114,56 +134,103 @@ func applyCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 		"path to where the PKI backup .tar.gz file should be placed (default: location of cluster config file)",
 	)
 
+	cmd.Flags().StringVar(
+		&opts.PlanFile,
+		longFlagName(opts, "PlanFile"),
+		"",
+		"path to the plan file saved by 'kubeone plan'; refuse to apply if the cluster no longer matches the plan",
+	)
+
 	cmd.Flags().BoolVar(
+		&opts.Resume,
+		longFlagName(opts, "Resume"),
+		false,
+		"resume the previously failed apply, skipping the tasks recorded as completed in the journal",
+	)
+
+	addApplyPlanFlags(cmd.Flags(), opts)
+
+	return cmd
+}
+
+// addApplyPlanFlags adds the flags that influence the actions taken by apply
+func addApplyPlanFlags(fs *pflag.FlagSet, opts *applyOpts) {
+	fs.BoolVar(
 		&opts.NoInit,
 		longFlagName(opts, "NoInit"),
 		false,
 		"don't initialize the cluster (only install binaries)",
 	)
 
-	cmd.Flags().BoolVar(
+	fs.BoolVar(
 		&opts.ForceInstall,
 		longFlagName(opts, "ForceInstall"),
 		false,
 		"use force to install new binary versions (!dangerous!)",
 	)
 
-	cmd.Flags().BoolVar(
+	fs.BoolVar(
 		&opts.ForceUpgrade,
 		longFlagName(opts, "ForceUpgrade"),
 		false,
 		"force start upgrade process",
 	)
 
-	cmd.Flags().BoolVar(
+	fs.BoolVar(
 		&opts.UpgradeMachineDeployments,
 		longFlagName(opts, "UpgradeMachineDeployments"),
 		false,
 		"upgrade MachineDeployments objects",
 	)
 
-	cmd.Flags().BoolVar(
+	fs.BoolVar(
 		&opts.PruneImages,
 		longFlagName(opts, "PruneImages"),
 		false,
 		"delete unused container images on control plane and static worker nodes",
 	)
 
-	cmd.Flags().BoolVar(
+	fs.BoolVar(
 		&opts.CreateMachineDeployments,
 		longFlagName(opts, "CreateMachineDeployments"),
 		true,
 		"create MachineDeployments objects",
 	)
 
-	cmd.Flags().BoolVar(
+	fs.BoolVar(
 		&opts.RotateEncryptionKey,
 		longFlagName(opts, "RotateEncryptionKey"),
 		false,
 		"rotate Encryption Provider encryption key",
 	)
 
-	return cmd
+	fs.BoolVar(
+		&opts.SkipEtcdSnapshot,
+		longFlagName(opts, "SkipEtcdSnapshot"),
+		false,
+		"don't save the etcd snapshot next to the backup file before upgrading, rotating the encryption key or repairing the cluster",
+	)
+
+	fs.IntVar(
+		&opts.EtcdSnapshotRetention,
+		longFlagName(opts, "EtcdSnapshotRetention"),
+		3,
+		"number of the automatic etcd snapshots to keep next to the backup file",
+	)
+
+	fs.StringSliceVar(
+		&opts.OnlyPhases,
+		longFlagName(opts, "OnlyPhases"),
+		nil,
+		fmt.Sprintf("comma separated list of the task phases to run when reconciling the provisioned cluster, one of: %s", strings.Join(tasks.Phases, ", ")),
+	)
+
+	fs.StringSliceVar(
+		&opts.SkipPhases,
+		longFlagName(opts, "SkipPhases"),
+		nil,
+		"comma separated list of the task phases to skip when reconciling the provisioned cluster",
+	)
 }
 
 func runApply(st *state.State, opts *applyOpts) error {
@@ -172,6 +239,24 @@ func runApply(st *state.State, opts *applyOpts) error {
 		return err
 	}
 
+	// the fingerprint has to be calculated before tasks start populating the
+	// cluster config with the probed information
+	fingerprint, err := clusterConfigFingerprint(st.Cluster)
+	if err != nil {
+		return err
+	}
+
+	var savedPlan *applyPlan
+	if opts.PlanFile != "" {
+		if savedPlan, err = readApplyPlan(opts.PlanFile); err != nil {
+			return err
+		}
+
+		if err = ensureNoManagedControlPlane(st); err != nil {
+			return err
+		}
+	}
+
 	managedCP, err := tasks.WithEnsureControlPlane(nil, st.Cluster)
 	if err != nil {
 		return err
@@ -193,313 +278,95 @@ func runApply(st *state.State, opts *applyOpts) error {
 	}
 
 	// Probe the cluster for the actual state and the needed tasks.
-	probbing := tasks.WithHostnameOS(nil)
-	probbing = tasks.WithProbesAndSafeguard(probbing)
-
-	if err := probbing.Run(st); err != nil {
+	if err := probeCluster(st); err != nil {
 		return err
 	}
 
-	if st.Verbose {
-		// Print information about hosts collected by probes
-		for _, host := range st.LiveCluster.ControlPlane {
-			printHostInformation(host)
-		}
-
-		for _, host := range st.LiveCluster.StaticWorkers {
-			printHostInformation(host)
-		}
-	}
-
 	// Reconcile the cluster based on the probe status
-	if !st.LiveCluster.IsProvisioned() {
-		return runApplyInstall(st, opts)
+	plan, tasksToRun, err := buildApplyPlan(st, opts)
+	if err != nil {
+		return err
 	}
 
-	if !st.LiveCluster.Healthy() {
-		if opts.RotateEncryptionKey {
-			return fail.RuntimeError{
-				Op:  "checking encryption key rotation",
-				Err: errors.New("cluster is not healthy, encryption key rotation is not supported"),
-			}
-		}
-
-		brokenHosts := st.LiveCluster.BrokenHosts()
-		if len(brokenHosts) > 0 {
-			for _, node := range brokenHosts {
-				st.Logger.Errorf("Host %q is broken and needs to be manually removed\n", node)
-			}
-
-			st.Logger.Warnf("Hosts must be removed in a correct order to preserve the Etcd quorum.")
-			st.Logger.Warnf("Loss of the Etcd quorum can cause loss of all data!!!")
-			st.Logger.Warnf("After removing the recommended hosts, run 'kubeone apply' before removing any other host.")
-
-			safeToDelete := st.LiveCluster.SafeToDeleteHosts()
-			if len(safeToDelete) > 0 {
-				st.Logger.Warnf("The recommended removal order:")
-				for _, safe := range safeToDelete {
-					st.Logger.Warnf("- %q", safe)
-				}
-			} else {
-				st.Logger.Warnf("No other broken node can be removed without losing quorum.")
-			}
-		}
-
-		runRepair := false
-		for _, node := range st.LiveCluster.ControlPlane {
-			if !node.IsInCluster {
-				runRepair = true
-
-				break
-			}
+	if savedPlan != nil {
+		if err := verifyApplyPlan(savedPlan, plan); err != nil {
+			return err
 		}
-
-		if !runRepair {
-			for _, node := range st.LiveCluster.StaticWorkers {
-				if !node.IsInCluster {
-					runRepair = true
-
-					break
-				}
-			}
-		}
-
-		if safeRepair, higherVer := st.LiveCluster.SafeToRepair(st.Cluster.Versions.Kubernetes); !safeRepair {
-			st.Logger.Errorln("Repair and upgrade are not supported at the same time!")
-			st.Logger.Warnf("Requested version: %s\n", st.Cluster.Versions.Kubernetes)
-			st.Logger.Warnf("Highest version: %s\n", higherVer)
-			st.Logger.Warnf("Use version %s to repair the cluster, then run apply with the new version\n", higherVer)
-
-			return fail.ConfigValidation(fmt.Errorf("repair and upgrade are not supported at the same time"))
-		}
-
-		if runRepair {
-			return runApplyInstall(st, opts)
-		}
-
-		if len(brokenHosts) > 0 {
-			return fail.NewConfigError("broken hosts check", "broken host(s) found, remove it manually")
-		}
-
-		return nil
 	}
 
-	if opts.RotateEncryptionKey {
-		if !st.EncryptionEnabled() {
-			return fail.ConfigValidation(fmt.Errorf("encryption Providers support is not enabled for this cluster"))
-		}
-
-		if st.Cluster.Features.EncryptionProviders != nil &&
-			st.Cluster.Features.EncryptionProviders.CustomEncryptionConfiguration != "" {
-			return fail.ConfigValidation(fmt.Errorf("key rotation of custom providers file is not supported"))
-		}
-
-		return runApplyRotateKey(st, opts)
+	if plan.Action == applyActionNone {
+		return nil
 	}
 
-	return runApplyUpgradeIfNeeded(st, opts)
-}
-
-func runApplyInstall(s *state.State, opts *applyOpts) error { // Print the expected changes
-	fmt.Println("The following actions will be taken: ")
-	fmt.Println("Run with --verbose flag for more information.")
+	printApplyPlan(plan, opts.Verbose)
 
-	for _, node := range s.LiveCluster.ControlPlane {
-		if !node.IsInCluster {
-			if node.Config.IsLeader {
-				fmt.Printf("\t+ initialize control plane node %q (%s) using %s\n", node.Config.Hostname, node.Config.PrivateAddress, s.Cluster.Versions.Kubernetes)
-			} else {
-				fmt.Printf("\t+ join control plane node %q (%s) using %s\n", node.Config.Hostname, node.Config.PrivateAddress, s.Cluster.Versions.Kubernetes)
-			}
+	// the saved plan has already been reviewed
+	if savedPlan == nil {
+		approved, err := confirmation.Approved(opts.AutoApprove)
+		if err != nil {
+			return err
 		}
-	}
 
-	for _, node := range s.LiveCluster.StaticWorkers {
-		if !node.IsInCluster {
-			fmt.Printf("\t+ join static worker node %q (%s)\n", node.Config.Hostname, node.Config.PrivateAddress)
-		}
-	}
-
-	if opts.NoInit {
-		fmt.Println("\t! NoInit option provided: only binaries will be installed")
-	}
+		if !approved {
+			st.Logger.Println("Operation canceled.")
 
-	if opts.ForceInstall {
-		fmt.Println("\t! force-install option provided: force install new binary versions (!dangerous!)")
-	}
-
-	if !s.LiveCluster.IsProvisioned() && opts.CreateMachineDeployments {
-		for _, node := range s.Cluster.DynamicWorkers {
-			fmt.Printf("\t+ ensure machinedeployment %q with %d replica(s) exists\n", node.Name, resolveInt(node.Replicas))
+			return nil
 		}
 	}
 
-	if s.Cluster.Addons.Enabled() && s.Cluster.Addons.Path != "" {
-		fmt.Printf("\t+ apply embedded and custom addons defined in %q\n", s.Cluster.Addons.Path)
-	} else if s.Cluster.Addons.Enabled() {
-		fmt.Print("\t+ apply embedded addons")
+	journalPath := state.JournalPath(st.BackupFile, st.Cluster.Name)
+	if opts.Resume {
+		st.Journal, err = state.LoadJournal(journalPath, fingerprint)
+	} else {
+		st.Journal, err = state.NewJournal(journalPath, fingerprint)
 	}
-
-	fmt.Println()
-	approved, err := confirmation.Approved(opts.AutoApprove)
 	if err != nil {
 		return err
 	}
 
-	if !approved {
-		s.Logger.Println("Operation canceled.")
-
-		return nil
-	}
-
-	if opts.NoInit {
-		return tasks.WithBinariesOnly(nil).Run(s)
-	}
-
-	return tasks.WithFullInstall(nil).Run(s)
-}
-
-func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
-	fmt.Println("The following actions will be taken: ")
-	if !opts.Verbose {
-		fmt.Println("Run with --verbose flag for more information.")
-	}
-
-	upgradeNeeded, err := s.LiveCluster.UpgradeNeeded()
-	if err != nil {
-		s.Logger.Errorf("Upgrade not allowed: %v\n", err)
+	if err = tasksToRun.Run(st); err != nil {
+		st.Logger.Warnf("Completed tasks are recorded in %q, run 'kubeone apply --resume' to continue from the failed task", journalPath)
 
 		return err
 	}
 
-	operations := []string{}
-
-	var tasksToRun tasks.Tasks
-
-	if hasExtraEtcdMembers, _ := etcdstatus.HasEtcdMemberCountExceededControlPlane(s); hasExtraEtcdMembers {
-		s.Logger.Warnf("The count for etcd members is higher than the control plane nodes, repairing the cluster if needed...")
-		operations = append(operations, "repairing the cluster; removing extra etcd members if needed")
-		tasksToRun = tasks.WithRemoveExtraEtcdMembers(tasksToRun)
-	}
-
-	if upgradeNeeded || opts.ForceUpgrade {
-		// disable case, we do this as early as possible.
-		if s.ShouldDisableEncryption() {
-			tasksToRun = tasks.WithDisableEncryptionProviders(tasksToRun, s.LiveCluster.EncryptionConfiguration.Custom)
-		}
-
-		tasksToRun = tasks.WithUpgrade(tasksToRun, s.Cluster.Followers(), s.Cluster.StaticWorkers.Hosts)
-
-		if s.ShouldEnableEncryption() {
-			operations = append(operations, "enable Encryption Provider support")
-			tasksToRun = tasks.WithRewriteSecrets(tasksToRun)
-		}
-
-		// custom encryption configuration was modified
-		if s.LiveCluster.CustomEncryptionEnabled() &&
-			s.Cluster.Features.EncryptionProviders != nil &&
-			s.Cluster.Features.EncryptionProviders.CustomEncryptionConfiguration != "" {
-			config := &apiserverconfigv1.EncryptionConfiguration{}
-			err = kyaml.UnmarshalStrict([]byte(s.Cluster.Features.EncryptionProviders.CustomEncryptionConfiguration), config)
-			if err != nil {
-				return err
-			}
-
-			if !reflect.DeepEqual(config, s.LiveCluster.EncryptionConfiguration.Config) {
-				operations = append(operations, []string{"update Encryption Provider configuration", "restart KubeAPI"}...)
-				tasksToRun = tasks.WithCustomEncryptionConfigUpdated(tasksToRun)
-			}
-		}
-
-		for _, node := range s.LiveCluster.ControlPlane {
-			forceFlag := ""
-			if opts.ForceUpgrade {
-				forceFlag = "force "
-			}
-
-			operations = append(operations,
-				fmt.Sprintf("%supgrade control plane node %q (%s): %s -> %s",
-					forceFlag,
-					node.Config.Hostname,
-					node.Config.PrivateAddress,
-					node.Kubelet.Version,
-					s.Cluster.Versions.Kubernetes))
-		}
-
-		for _, node := range s.LiveCluster.StaticWorkers {
-			forceFlag := ""
-			if opts.ForceUpgrade {
-				forceFlag = "force "
-			}
-			operations = append(operations,
-				fmt.Sprintf("%supgrade worker node %q (%s): %s -> %s",
-					forceFlag,
-					node.Config.Hostname,
-					node.Config.PrivateAddress,
-					node.Kubelet.Version,
-					s.Cluster.Versions.Kubernetes))
-		}
-	} else {
-		tasksToRun = tasks.WithResources(tasksToRun)
-	}
-
-	fmt.Println()
-	for _, op := range operations {
-		fmt.Printf("\t~ %s\n", op)
-	}
-
-	for _, op := range tasksToRun.Descriptions(s) {
-		fmt.Printf("\t~ %s\n", op)
-	}
+	return st.Journal.Remove()
+}
 
-	fmt.Println()
-	approved, err := confirmation.Approved(opts.AutoApprove)
+// clusterConfigFingerprint returns a checksum of the cluster configuration
+// used to make sure the journal is resumed only for the same configuration
+func clusterConfigFingerprint(cluster *kubeoneapi.KubeOneCluster) (string, error) {
+	buf, err := json.Marshal(cluster)
 	if err != nil {
-		return err
+		return "", fail.Runtime(err, "marshalling cluster configuration")
 	}
 
-	if !approved {
-		s.Logger.Println("Operation canceled.")
-
-		return nil
-	}
+	sum := sha256.Sum256(buf)
 
-	return tasksToRun.Run(s)
+	return hex.EncodeToString(sum[:]), nil
 }
 
-func runApplyRotateKey(s *state.State, opts *applyOpts) error {
-	if !opts.ForceUpgrade {
-		s.Logger.Error("rotating encryption keys requires the --force-upgrade flag")
-
-		return fail.ConfigValidation(fmt.Errorf("rotating encryption keys requires the --force-upgrade flag"))
-	}
-	if !s.EncryptionEnabled() {
-		s.Logger.Error("rotating encryption keys failed: Encryption Providers support is not enabled")
-
-		return fail.ConfigValidation(fmt.Errorf("rotating encryption keys failed: Encryption Providers support is not enabled"))
-	}
-
-	fmt.Println("The following actions will be taken: ")
-	fmt.Println("Run with --verbose flag for more information.")
-	tasksToRun := tasks.WithRotateKey(nil)
-
-	for _, op := range tasksToRun.Descriptions(s) {
-		fmt.Printf("\t~ %s\n", op)
-	}
+// probeCluster detects hosts OS and hostnames and runs probes against them
+func probeCluster(st *state.State) error {
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbesAndSafeguard(probbing)
 
-	fmt.Println()
-	approved, err := confirmation.Approved(opts.AutoApprove)
-	if err != nil {
+	if err := probbing.Run(st); err != nil {
 		return err
 	}
 
-	if !approved {
-		s.Logger.Println("Operation canceled.")
+	if st.Verbose {
+		// Print information about hosts collected by probes
+		for _, host := range st.LiveCluster.ControlPlane {
+			printHostInformation(host)
+		}
 
-		return nil
+		for _, host := range st.LiveCluster.StaticWorkers {
+			printHostInformation(host)
+		}
 	}
 
-	return tasksToRun.Run(s)
+	return nil
 }
 
 func printHostInformation(host state.Host) {
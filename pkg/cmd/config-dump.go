@@ -19,16 +19,19 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"k8c.io/kubeone/pkg/apis/kubeone/config"
 	kubeonescheme "k8c.io/kubeone/pkg/apis/kubeone/scheme"
-	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/tasks"
 	"k8c.io/kubeone/pkg/templates"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
 )
 
 type configDumpOpts struct {
@@ -39,10 +42,22 @@ func configDumpCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 	opts := &configDumpOpts{}
 
 	cmd := &cobra.Command{
-		Use:           "dump",
-		Short:         "Merge the KubeOneCluster manifest with the Terraform state and dump it to the stdout",
+		Use:   "dump",
+		Short: "Merge the KubeOneCluster manifests with the Terraform state and dump it to the stdout",
+		Long: heredoc.Doc(`
+			Merge the KubeOneCluster manifests with the Terraform state, apply the defaults and dump the result to the
+			stdout, in the API version of the manifests.
+
+			With multiple '--manifest' flags, every value is commented with the manifest file it came from. The values
+			without the comment are the defaults or come from the Terraform state.
+		`),
 		SilenceErrors: true,
-		Example:       `kubeone config dump -m kubeone.yaml -t tf.json`,
+		Example: heredoc.Doc(`
+			kubeone config dump -m kubeone.yaml -t tf.json
+
+			# To see the per-environment manifest merged with the base one
+			kubeone config dump -m base.yaml -m production.yaml
+		`),
 		RunE: func(*cobra.Command, []string) error {
 			gopts, err := persistentGlobalOptions(rootFlags)
 			if err != nil {
@@ -68,18 +83,50 @@ func dumpConfig(opts *configDumpOpts) error {
 		return err
 	}
 
-	v1beta2Cluster := kubeonev1beta2.NewKubeOneCluster()
-	if err = kubeonescheme.Scheme.Convert(st.Cluster, v1beta2Cluster, nil); err != nil {
-		return fail.Config(err, fmt.Sprintf("converting %s to internal object", v1beta2Cluster.GroupVersionKind()))
+	manifest, provenance, err := config.ReadManifests(opts.ManifestFiles)
+	if err != nil {
+		return err
+	}
+
+	typeMeta := runtime.TypeMeta{}
+	if err = yaml.Unmarshal(manifest, &typeMeta); err != nil {
+		return fail.Config(err, "unmarshal cluster typeMeta")
+	}
+	gvk := schema.FromAPIVersionAndKind(typeMeta.APIVersion, typeMeta.Kind)
+
+	versionedCluster, err := kubeonescheme.Scheme.New(gvk)
+	if err != nil {
+		return fail.Config(err, fmt.Sprintf("creating %s object", gvk))
+	}
+
+	if err = kubeonescheme.Scheme.Convert(st.Cluster, versionedCluster, nil); err != nil {
+		return fail.Config(err, fmt.Sprintf("converting internal object to %s", gvk))
+	}
+	versionedCluster.GetObjectKind().SetGroupVersionKind(gvk)
+
+	if len(opts.ManifestFiles) == 1 {
+		// Convert the KubeOneCluster struct to the YAML representation
+		clusterYAML, yamlErr := templates.KubernetesToYAML([]runtime.Object{versionedCluster})
+		if yamlErr != nil {
+			return yamlErr
+		}
+
+		fmt.Println(clusterYAML)
+
+		return nil
+	}
+
+	clusterYAML, err := yaml.Marshal(versionedCluster)
+	if err != nil {
+		return fail.Runtime(err, "marshalling runtime.Object")
 	}
 
-	// Convert the KubeOneCluster struct to the YAML representation
-	clusterYAML, err := templates.KubernetesToYAML([]runtime.Object{v1beta2Cluster})
+	annotatedYAML, err := provenance.Annotate(clusterYAML)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(clusterYAML)
+	fmt.Print(string(annotatedYAML))
 
 	return nil
 }
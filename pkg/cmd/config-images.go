@@ -29,10 +29,10 @@ import (
 )
 
 type listImagesOpts struct {
-	ManifestFile      string `longflag:"manifest" shortflag:"m"`
-	Filter            string `longflag:"filter"`
-	KubernetesVersion string `longflag:"kubernetes-version" shortflag:"k"`
-	AllImages         bool   `longflag:"all" shortflag:"a"`
+	ManifestFiles     []string `longflag:"manifest" shortflag:"m"`
+	Filter            string   `longflag:"filter"`
+	KubernetesVersion string   `longflag:"kubernetes-version" shortflag:"k"`
+	AllImages         bool     `longflag:"all" shortflag:"a"`
 }
 
 func configImagesCmd(rootFlags *pflag.FlagSet) *cobra.Command {
@@ -72,11 +72,11 @@ func listImagesCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 		`),
 		SilenceErrors: true,
 		RunE: func(*cobra.Command, []string) error {
-			manifestFile, err := rootFlags.GetString(longFlagName(opts, "ManifestFile"))
+			manifestFiles, err := rootFlags.GetStringArray(longFlagName(opts, "ManifestFiles"))
 			if err != nil {
-				return fail.Runtime(err, "getting ManifestFile flag")
+				return fail.Runtime(err, "getting ManifestFiles flag")
 			}
-			opts.ManifestFile = manifestFile
+			opts.ManifestFiles = manifestFiles
 
 			return listImages(opts)
 		},
@@ -122,7 +122,7 @@ func listImages(opts *listImagesOpts) error {
 		}
 	}
 
-	imgResolver, err := newImageResolver(opts.KubernetesVersion, opts.ManifestFile)
+	imgResolver, err := newImageResolver(opts.KubernetesVersion, opts.ManifestFiles)
 	if err != nil {
 		return err
 	}
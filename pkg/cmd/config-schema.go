@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"k8c.io/kubeone/pkg/apis/kubeone/jsonschema"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+type configSchemaOpts struct {
+	APIVersion string `longflag:"api-version"`
+	Format     string `longflag:"format"`
+}
+
+func configSchemaCmd() *cobra.Command {
+	opts := &configSchemaOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the KubeOneCluster manifest",
+		Long: heredoc.Doc(`
+			Print the JSON Schema or the OpenAPI v3 schema of the KubeOneCluster manifest,
+			generated from the API types. Editors can use the schema to validate and
+			autocomplete the manifests, for example with the yaml-language-server modeline:
+
+			  # yaml-language-server: $schema=kubeone-schema.json
+		`),
+		Args: cobra.ExactArgs(0),
+		Example: heredoc.Doc(`
+			# To save the JSON Schema of the latest API version
+			kubeone config schema > kubeone-schema.json
+
+			# To see the OpenAPI v3 schema of the v1beta3 API version
+			kubeone config schema --api-version kubeone.k8c.io/v1beta3 --format openapi
+		`),
+		SilenceErrors: true,
+		RunE: func(*cobra.Command, []string) error {
+			return printSchema(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&opts.APIVersion,
+		longFlagName(opts, "APIVersion"),
+		jsonschema.Versions[len(jsonschema.Versions)-1].APIVersion,
+		"API version of the KubeOneCluster manifest",
+	)
+
+	cmd.Flags().StringVar(
+		&opts.Format,
+		longFlagName(opts, "Format"),
+		jsonschema.FormatJSONSchema,
+		fmt.Sprintf("schema format, one of the [%s|%s]", jsonschema.FormatJSONSchema, jsonschema.FormatOpenAPI),
+	)
+
+	return cmd
+}
+
+func printSchema(opts *configSchemaOpts) error {
+	var (
+		schema []byte
+		err    error
+	)
+
+	switch opts.Format {
+	case jsonschema.FormatJSONSchema:
+		schema, err = jsonschema.JSONSchema(opts.APIVersion)
+	case jsonschema.FormatOpenAPI:
+		schema, err = jsonschema.OpenAPI(opts.APIVersion)
+	default:
+		err = errors.Errorf("--format can be only one of [%s|%s]", jsonschema.FormatJSONSchema, jsonschema.FormatOpenAPI)
+	}
+	if err != nil {
+		return fail.Runtime(err, "printing schema")
+	}
+
+	_, err = os.Stdout.Write(schema)
+
+	return fail.Runtime(err, "printing schema")
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/spf13/pflag"
 	yaml "gopkg.in/yaml.v2"
 
+	"k8c.io/kubeone/pkg/apis/kubeone/config"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
 	"k8c.io/kubeone/pkg/containerruntime"
 	"k8c.io/kubeone/pkg/fail"
@@ -101,6 +102,7 @@ func configCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 	cmd.AddCommand(configMigrateCmd(rootFlags))
 	cmd.AddCommand(configMachinedeploymentsCmd(rootFlags))
 	cmd.AddCommand(configImagesCmd(rootFlags))
+	cmd.AddCommand(configSchemaCmd())
 
 	return cmd
 }
@@ -209,28 +211,28 @@ func configPrintCmd() *cobra.Command {
 }
 
 // configMigrateCmd setups the migrate command
-func configMigrateCmd(_ *pflag.FlagSet) *cobra.Command {
+func configMigrateCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate",
-		Short: "Migrate the v1beta2 KubeOneCluster manifest to the v1beta3 version",
+		Short: "Migrate the v1beta2 or v1beta3 KubeOneCluster manifest to the v1beta4 version",
 		Long: `
-Migrate the v1beta2 KubeOneCluster manifest to the v1beta3 version.
-The v1beta2 version of the KubeOneCluster manifest is deprecated and will be
-removed in one of the next versions.
+Migrate the v1beta2 or v1beta3 KubeOneCluster manifest to the v1beta4 version.
+The v1beta2 manifest is migrated to v1beta3 first, applying the Terraform
+output if given, and then to v1beta4. The v1beta3 version of the
+KubeOneCluster manifest is deprecated and will be removed in one of the next
+versions.
 The new manifest is printed on the standard output.
 `,
 		Args:          cobra.ExactArgs(0),
 		Example:       `kubeone config migrate --manifest mycluster.yaml`,
 		SilenceErrors: true,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			// gopts, err := persistentGlobalOptions(rootFlags)
-			// if err != nil {
-			// 	return err
-			// }
-
-			// return runMigrate(gopts)
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
 
-			return errors.New("migrating to the v1beta3 API is not possible in this KubeOne version")
+			return runMigrate(gopts)
 		},
 	}
 
@@ -481,29 +483,36 @@ func parseControlPlaneHosts(cfg *yamled.Document, hostList string) error {
 	return nil
 }
 
-// runMigrate migrates the KubeOneCluster manifest from v1alpha1 to v1beta1
-// func runMigrate(opts *globalOptions) error {
-// 	var (
-// 		tfOutput []byte
-// 		err      error
-// 	)
-
-// 	if opts.TerraformState != "" {
-// 		tfOutput, err = config.TFOutput(opts.TerraformState)
-// 		if err != nil {
-// 			return err
-// 		}
-// 	}
-
-// 	v1beta3Manifest, err := config.MigrateV1beta2V1beta3(opts.ManifestFile, tfOutput)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	fmt.Printf("%s\n", v1beta3Manifest)
-
-// 	return nil
-// }
+// runMigrate migrates the KubeOneCluster manifest to the latest API version
+func runMigrate(opts *globalOptions) error {
+	if len(opts.ManifestFiles) > 1 {
+		return fail.RuntimeError{
+			Op:  "checking --manifest flag",
+			Err: errors.New("the manifests can be migrated only one by one"),
+		}
+	}
+
+	var (
+		tfOutput []byte
+		err      error
+	)
+
+	if opts.TerraformState != "" {
+		tfOutput, err = config.TFOutput(opts.TerraformState)
+		if err != nil {
+			return err
+		}
+	}
+
+	v1beta4Manifest, err := config.Migrate(opts.ManifestFile, tfOutput)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", v1beta4Manifest)
+
+	return nil
+}
 
 // runGenerateMachineDeployments generates the MachineDeployments manifest
 func runGenerateMachineDeployments(opts *globalOptions) error {
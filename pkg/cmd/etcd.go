@@ -396,20 +396,6 @@ func etcdSnapshotCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 				return err
 			}
 
-			etcdcli, err := etcdutil.NewClient(s)
-			if err != nil {
-				return err
-			}
-			defer etcdcli.Close()
-
-			maintenance := clientv3.NewMaintenance(etcdcli)
-
-			snapshotResp, err := maintenance.SnapshotWithVersion(s.Context)
-			if err != nil {
-				return fail.Etcd(err, "requesting snapshot")
-			}
-			defer snapshotResp.Snapshot.Close()
-
 			outPath := args[0]
 			var output io.WriteCloser = os.Stdout
 
@@ -422,11 +408,12 @@ func etcdSnapshotCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 			}
 			defer output.Close()
 
-			if _, err = io.Copy(output, snapshotResp.Snapshot); err != nil {
-				return fail.Runtime(err, "writing snapshot to %q", outPath)
+			version, err := etcdutil.Snapshot(s, output)
+			if err != nil {
+				return err
 			}
 
-			s.Logger.Infof("Snapshot saved to %q (etcd version: %s).\n", outPath, snapshotResp.Version)
+			s.Logger.Infof("Snapshot saved to %q (etcd version: %s).\n", outPath, version)
 
 			return nil
 		},
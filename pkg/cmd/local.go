@@ -17,7 +17,6 @@ limitations under the License.
 package cmd
 
 import (
-	"context"
 	"os"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -32,6 +31,7 @@ import (
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tracing"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -64,7 +64,7 @@ func (opts *localOpts) BuildState() (*state.State, error) {
 	}
 
 	if haveManifest {
-		cluster, err = loadClusterConfig(opts.ManifestFile, "", "", logger)
+		cluster, err = loadClusterConfig(opts.ManifestFiles, "", "", logger)
 		if err != nil {
 			return nil, err
 		}
@@ -72,17 +72,26 @@ func (opts *localOpts) BuildState() (*state.State, error) {
 	} else {
 		cluster = generateLocalCluster(opts.KubernetesVersion, opts.APIEndpoint)
 	}
-	rootContext := context.Background()
+	graceful, abort := signalContexts(logger)
 
-	localExec := executor.NewLocal(rootContext)
+	localExec, err := opts.executorAdapter(executor.NewLocal(abort))
+	if err != nil {
+		return nil, err
+	}
 
-	s, err := state.New(rootContext, state.WithExecutorAdapter(localExec))
+	s, err := state.New(tracing.WithRootSpan(graceful), state.WithExecutorAdapter(localExec))
 	if err != nil {
 		return nil, err
 	}
 
 	s.Logger = logger
+	s.AbortContext = abort
 	s.Cluster = cluster
+	s.Retry = opts.retryPolicy(cluster)
+
+	if s.Events, err = opts.openEvents(); err != nil {
+		return nil, err
+	}
 
 	// Validate Addons path if provided
 	if s.Cluster.Addons.Enabled() {
@@ -0,0 +1,828 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/clusterstatus/etcdstatus"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tasks"
+
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	kyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	applyPlanAPIVersion = "plan.kubeone.k8c.io/v1alpha1"
+	applyPlanKind       = "ApplyPlan"
+)
+
+type applyAction string
+
+const (
+	applyActionNone      applyAction = "none"
+	applyActionInstall   applyAction = "install"
+	applyActionRepair    applyAction = "repair"
+	applyActionUpgrade   applyAction = "upgrade"
+	applyActionReconcile applyAction = "reconcile"
+	applyActionRotateKey applyAction = "rotate-encryption-key"
+)
+
+const (
+	nodeRoleControlPlane = "control-plane"
+	nodeRoleStaticWorker = "static-worker"
+)
+
+// applyPlan is a machine-readable description of what `kubeone apply` is
+// going to do with the cluster, based on the probed cluster state.
+type applyPlan struct {
+	APIVersion        string      `json:"apiVersion"`
+	Kind              string      `json:"kind"`
+	ClusterName       string      `json:"clusterName"`
+	KubernetesVersion string      `json:"kubernetesVersion"`
+	Action            applyAction `json:"action"`
+	// Fingerprint is a checksum of the probed cluster state the plan is
+	// computed from
+	Fingerprint  string              `json:"fingerprint"`
+	Flags        applyPlanFlags      `json:"flags"`
+	Operations   []applyPlanOp       `json:"operations,omitempty"`
+	Tasks        []string            `json:"tasks,omitempty"`
+	Nodes        []applyPlanNode     `json:"nodes,omitempty"`
+	Addons       []applyPlanAddon    `json:"addons,omitempty"`
+	HelmReleases []applyPlanHelmRels `json:"helmReleases,omitempty"`
+}
+
+// applyPlanFlags are the apply flags that influence the plan
+type applyPlanFlags struct {
+	NoInit                    bool     `json:"noInit,omitempty"`
+	ForceInstall              bool     `json:"forceInstall,omitempty"`
+	ForceUpgrade              bool     `json:"forceUpgrade,omitempty"`
+	UpgradeMachineDeployments bool     `json:"upgradeMachineDeployments,omitempty"`
+	PruneImages               bool     `json:"pruneImages,omitempty"`
+	CreateMachineDeployments  bool     `json:"createMachineDeployments,omitempty"`
+	RotateEncryptionKey       bool     `json:"rotateEncryptionKey,omitempty"`
+	OnlyPhases                []string `json:"onlyPhases,omitempty"`
+	SkipPhases                []string `json:"skipPhases,omitempty"`
+}
+
+// applyPlanOp is a human-readable operation, Sign is one of "+" (create),
+// "~" (modify) or "!" (notice)
+type applyPlanOp struct {
+	Sign        string `json:"sign"`
+	Description string `json:"description"`
+
+	// phase is the task phase carrying out the operation, the operation is
+	// left out of the plan if the phase is filtered out
+	phase string
+}
+
+type applyPlanNode struct {
+	Hostname          string `json:"hostname"`
+	PublicAddress     string `json:"publicAddress,omitempty"`
+	PrivateAddress    string `json:"privateAddress,omitempty"`
+	Role              string `json:"role"`
+	Leader            bool   `json:"leader,omitempty"`
+	InCluster         bool   `json:"inCluster"`
+	Initialized       bool   `json:"initialized"`
+	KubeletStatus     uint64 `json:"kubeletStatus"`
+	ContainerdStatus  uint64 `json:"containerdStatus"`
+	ContainerdVersion string `json:"containerdVersion,omitempty"`
+	APIServerStatus   uint64 `json:"apiserverStatus,omitempty"`
+	EtcdStatus        uint64 `json:"etcdStatus,omitempty"`
+	// Action is what is going to happen with the node, one of "initialize",
+	// "join", "upgrade" or "none"
+	Action      string `json:"action"`
+	FromVersion string `json:"fromVersion,omitempty"`
+	ToVersion   string `json:"toVersion,omitempty"`
+}
+
+type applyPlanAddon struct {
+	Name   string `json:"name,omitempty"`
+	Path   string `json:"path,omitempty"`
+	Action string `json:"action"`
+}
+
+type applyPlanHelmRels struct {
+	ReleaseName string `json:"releaseName"`
+	Namespace   string `json:"namespace"`
+	Chart       string `json:"chart"`
+	Version     string `json:"version,omitempty"`
+	Action      string `json:"action"`
+}
+
+type planOpts struct {
+	applyOpts
+	OutputFile string `longflag:"output" shortflag:"o"`
+}
+
+func planCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &planOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show and save the changes apply would make",
+		Long: heredoc.Doc(`
+			Probe the cluster and compute the actions 'kubeone apply' would take (install, upgrade or repair), without
+			changing anything.
+
+			The plan can be saved to a JSON file using the '--output' flag, reviewed, and later executed using
+			'kubeone apply --plan'. Apply refuses to run a saved plan if the cluster has changed in the meantime.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone plan -m mycluster.yaml -t terraformoutput.json -o plan.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			st, err := opts.globalOptions.BuildState()
+			if err != nil {
+				return err
+			}
+			opts.applyToState(st)
+
+			return runPlan(st, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&opts.OutputFile,
+		longFlagName(opts, "OutputFile"),
+		shortFlagName(opts, "OutputFile"),
+		"",
+		"path to the file where the plan should be saved in JSON format",
+	)
+
+	addApplyPlanFlags(cmd.Flags(), &opts.applyOpts)
+
+	return cmd
+}
+
+func runPlan(st *state.State, opts *planOpts) error {
+	if err := validateCredentials(st, opts.CredentialsFile); err != nil {
+		return err
+	}
+
+	if err := ensureNoManagedControlPlane(st); err != nil {
+		return err
+	}
+
+	if err := probeCluster(st); err != nil {
+		return err
+	}
+
+	plan, _, err := buildApplyPlan(st, &opts.applyOpts)
+	if err != nil {
+		return err
+	}
+
+	printApplyPlan(plan, opts.Verbose)
+
+	if opts.OutputFile == "" {
+		return nil
+	}
+
+	return writeApplyPlan(opts.OutputFile, plan)
+}
+
+// ensureNoManagedControlPlane returns an error if the control plane hosts are
+// provisioned by KubeOne itself, as such hosts can't be probed before they
+// are created.
+func ensureNoManagedControlPlane(st *state.State) error {
+	managedCP, err := tasks.WithEnsureControlPlane(nil, st.Cluster)
+	if err != nil {
+		return err
+	}
+
+	if len(managedCP.Descriptions(st)) > 0 {
+		return fail.NewConfigError("planning", "saved plans are not supported for clusters with a managed control plane")
+	}
+
+	return nil
+}
+
+// buildApplyPlan decides how the cluster is going to be reconciled based on
+// the probed state and returns the plan together with the tasks to run.
+func buildApplyPlan(st *state.State, opts *applyOpts) (*applyPlan, tasks.Tasks, error) {
+	plan := &applyPlan{
+		APIVersion:        applyPlanAPIVersion,
+		Kind:              applyPlanKind,
+		ClusterName:       st.Cluster.Name,
+		KubernetesVersion: st.Cluster.Versions.Kubernetes,
+		Action:            applyActionNone,
+		Flags: applyPlanFlags{
+			NoInit:                    opts.NoInit,
+			ForceInstall:              opts.ForceInstall,
+			ForceUpgrade:              opts.ForceUpgrade,
+			UpgradeMachineDeployments: opts.UpgradeMachineDeployments,
+			PruneImages:               opts.PruneImages,
+			CreateMachineDeployments:  opts.CreateMachineDeployments,
+			RotateEncryptionKey:       opts.RotateEncryptionKey,
+			OnlyPhases:                opts.OnlyPhases,
+			SkipPhases:                opts.SkipPhases,
+		},
+	}
+
+	phases := opts.phaseFilter()
+	if err := phases.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	plan.Nodes = planNodes(st)
+
+	fingerprint, err := probedStateFingerprint(st, plan.Nodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan.Fingerprint = fingerprint
+
+	var tasksToRun tasks.Tasks
+
+	switch {
+	case !st.LiveCluster.IsProvisioned():
+		plan.Action = applyActionInstall
+		if err = checkPhaseFilter(plan.Action, phases); err != nil {
+			return nil, nil, err
+		}
+
+		tasksToRun = planInstall(st, plan, opts)
+	case !st.LiveCluster.Healthy():
+		if opts.RotateEncryptionKey {
+			return nil, nil, fail.RuntimeError{
+				Op:  "checking encryption key rotation",
+				Err: errors.New("cluster is not healthy, encryption key rotation is not supported"),
+			}
+		}
+
+		runRepair, err := checkRepair(st)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if runRepair {
+			plan.Action = applyActionRepair
+			if err = checkPhaseFilter(plan.Action, phases); err != nil {
+				return nil, nil, err
+			}
+
+			tasksToRun = planInstall(st, plan, opts)
+		}
+	case opts.RotateEncryptionKey:
+		if !st.EncryptionEnabled() {
+			return nil, nil, fail.ConfigValidation(fmt.Errorf("encryption Providers support is not enabled for this cluster"))
+		}
+
+		if st.Cluster.Features.EncryptionProviders != nil &&
+			st.Cluster.Features.EncryptionProviders.CustomEncryptionConfiguration != "" {
+			return nil, nil, fail.ConfigValidation(fmt.Errorf("key rotation of custom providers file is not supported"))
+		}
+
+		if !opts.ForceUpgrade {
+			st.Logger.Error("rotating encryption keys requires the --force-upgrade flag")
+
+			return nil, nil, fail.ConfigValidation(fmt.Errorf("rotating encryption keys requires the --force-upgrade flag"))
+		}
+
+		plan.Action = applyActionRotateKey
+		if err = checkPhaseFilter(plan.Action, phases); err != nil {
+			return nil, nil, err
+		}
+
+		tasksToRun = tasks.WithRotateKey(nil)
+	default:
+		tasksToRun, err = planUpgrade(st, plan, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if tasksToRun, err = tasksToRun.WithPhases(phases); err != nil {
+		return nil, nil, err
+	}
+
+	plan.Operations = selectedPhaseOps(plan.Operations, phases)
+
+	switch plan.Action {
+	case applyActionUpgrade, applyActionReconcile, applyActionRotateKey:
+		plan.Operations = append(plan.Operations, modifyOps(tasksToRun.Descriptions(st))...)
+	}
+
+	plan.Tasks = tasksToRun.Descriptions(st)
+
+	switch plan.Action {
+	case applyActionInstall, applyActionRepair:
+		if opts.NoInit {
+			break
+		}
+
+		fallthrough
+	case applyActionUpgrade, applyActionReconcile:
+		plan.Addons, plan.HelmReleases = planAddons(st)
+		if !phases.Selected(tasks.PhaseAddons) {
+			plan.Addons = nil
+		}
+		if !phases.Selected(tasks.PhaseHelmReleases) {
+			plan.HelmReleases = nil
+		}
+	}
+
+	return plan, tasksToRun, nil
+}
+
+// checkPhaseFilter rejects selecting the phases of the actions other than
+// reconciling the provisioned cluster. Installing, repairing, upgrading and
+// rotating the encryption key can't be run partially, since their tasks
+// depend on the previous ones being completed.
+func checkPhaseFilter(action applyAction, phases tasks.PhaseFilter) error {
+	if phases.Empty() || action == applyActionReconcile {
+		return nil
+	}
+
+	return fail.ConfigValidation(fmt.Errorf("the --only and --skip flags can be used only to reconcile the provisioned cluster, but the %s action is planned", action))
+}
+
+// checkRepair reports broken hosts and decides if the unhealthy cluster
+// should be repaired.
+func checkRepair(st *state.State) (bool, error) {
+	brokenHosts := st.LiveCluster.BrokenHosts()
+	if len(brokenHosts) > 0 {
+		for _, node := range brokenHosts {
+			st.Logger.Errorf("Host %q is broken and needs to be manually removed\n", node)
+		}
+
+		st.Logger.Warnf("Hosts must be removed in a correct order to preserve the Etcd quorum.")
+		st.Logger.Warnf("Loss of the Etcd quorum can cause loss of all data!!!")
+		st.Logger.Warnf("After removing the recommended hosts, run 'kubeone apply' before removing any other host.")
+
+		safeToDelete := st.LiveCluster.SafeToDeleteHosts()
+		if len(safeToDelete) > 0 {
+			st.Logger.Warnf("The recommended removal order:")
+			for _, safe := range safeToDelete {
+				st.Logger.Warnf("- %q", safe)
+			}
+		} else {
+			st.Logger.Warnf("No other broken node can be removed without losing quorum.")
+		}
+	}
+
+	runRepair := false
+	for _, node := range st.LiveCluster.ControlPlane {
+		if !node.IsInCluster {
+			runRepair = true
+
+			break
+		}
+	}
+
+	if !runRepair {
+		for _, node := range st.LiveCluster.StaticWorkers {
+			if !node.IsInCluster {
+				runRepair = true
+
+				break
+			}
+		}
+	}
+
+	if safeRepair, higherVer := st.LiveCluster.SafeToRepair(st.Cluster.Versions.Kubernetes); !safeRepair {
+		st.Logger.Errorln("Repair and upgrade are not supported at the same time!")
+		st.Logger.Warnf("Requested version: %s\n", st.Cluster.Versions.Kubernetes)
+		st.Logger.Warnf("Highest version: %s\n", higherVer)
+		st.Logger.Warnf("Use version %s to repair the cluster, then run apply with the new version\n", higherVer)
+
+		return false, fail.ConfigValidation(fmt.Errorf("repair and upgrade are not supported at the same time"))
+	}
+
+	if runRepair {
+		return true, nil
+	}
+
+	if len(brokenHosts) > 0 {
+		return false, fail.NewConfigError("broken hosts check", "broken host(s) found, remove it manually")
+	}
+
+	return false, nil
+}
+
+func planInstall(s *state.State, plan *applyPlan, opts *applyOpts) tasks.Tasks {
+	for i, node := range s.LiveCluster.ControlPlane {
+		if node.IsInCluster {
+			continue
+		}
+
+		if node.Config.IsLeader {
+			plan.Nodes[i].Action = "initialize"
+			plan.Operations = append(plan.Operations, applyPlanOp{
+				Sign:        "+",
+				Description: fmt.Sprintf("initialize control plane node %q (%s) using %s", node.Config.Hostname, node.Config.PrivateAddress, s.Cluster.Versions.Kubernetes),
+				phase:       tasks.PhaseControlPlane,
+			})
+		} else {
+			plan.Nodes[i].Action = "join"
+			plan.Operations = append(plan.Operations, applyPlanOp{
+				Sign:        "+",
+				Description: fmt.Sprintf("join control plane node %q (%s) using %s", node.Config.Hostname, node.Config.PrivateAddress, s.Cluster.Versions.Kubernetes),
+				phase:       tasks.PhaseControlPlane,
+			})
+		}
+		plan.Nodes[i].ToVersion = s.Cluster.Versions.Kubernetes
+	}
+
+	for i, node := range s.LiveCluster.StaticWorkers {
+		if node.IsInCluster {
+			continue
+		}
+
+		idx := len(s.LiveCluster.ControlPlane) + i
+		plan.Nodes[idx].Action = "join"
+		plan.Nodes[idx].ToVersion = s.Cluster.Versions.Kubernetes
+		plan.Operations = append(plan.Operations, applyPlanOp{
+			Sign:        "+",
+			Description: fmt.Sprintf("join static worker node %q (%s)", node.Config.Hostname, node.Config.PrivateAddress),
+			phase:       tasks.PhaseStaticWorkers,
+		})
+	}
+
+	if opts.NoInit {
+		plan.Operations = append(plan.Operations, applyPlanOp{Sign: "!", Description: "NoInit option provided: only binaries will be installed"})
+	}
+
+	if opts.ForceInstall {
+		plan.Operations = append(plan.Operations, applyPlanOp{Sign: "!", Description: "force-install option provided: force install new binary versions (!dangerous!)"})
+	}
+
+	if !s.LiveCluster.IsProvisioned() && opts.CreateMachineDeployments {
+		for _, node := range s.Cluster.DynamicWorkers {
+			plan.Operations = append(plan.Operations, applyPlanOp{
+				Sign:        "+",
+				Description: fmt.Sprintf("ensure machinedeployment %q with %d replica(s) exists", node.Name, resolveInt(node.Replicas)),
+				phase:       tasks.PhaseMachineDeployments,
+			})
+		}
+	}
+
+	if s.Cluster.Addons.Enabled() && s.Cluster.Addons.Path != "" {
+		plan.Operations = append(plan.Operations, applyPlanOp{Sign: "+", Description: fmt.Sprintf("apply embedded and custom addons defined in %q", s.Cluster.Addons.Path), phase: tasks.PhaseAddons})
+	} else if s.Cluster.Addons.Enabled() {
+		plan.Operations = append(plan.Operations, applyPlanOp{Sign: "+", Description: "apply embedded addons", phase: tasks.PhaseAddons})
+	}
+
+	if opts.NoInit {
+		return tasks.WithBinariesOnly(nil)
+	}
+
+	if plan.Action == applyActionRepair {
+		return tasks.WithRepair(nil)
+	}
+
+	return tasks.WithFullInstall(nil)
+}
+
+func planUpgrade(s *state.State, plan *applyPlan, opts *applyOpts) (tasks.Tasks, error) {
+	upgradeNeeded, err := s.LiveCluster.UpgradeNeeded()
+	if err != nil {
+		s.Logger.Errorf("Upgrade not allowed: %v\n", err)
+
+		return nil, err
+	}
+
+	if upgradeNeeded || opts.ForceUpgrade {
+		if err = checkPhaseFilter(applyActionUpgrade, opts.phaseFilter()); err != nil {
+			return nil, err
+		}
+	}
+
+	operations := []applyPlanOp{}
+
+	var tasksToRun tasks.Tasks
+
+	if hasExtraEtcdMembers, _ := etcdstatus.HasEtcdMemberCountExceededControlPlane(s); hasExtraEtcdMembers {
+		s.Logger.Warnf("The count for etcd members is higher than the control plane nodes, repairing the cluster if needed...")
+		operations = append(operations, applyPlanOp{Sign: "~", Description: "repairing the cluster; removing extra etcd members if needed", phase: tasks.PhaseControlPlane})
+		tasksToRun = tasks.WithRemoveExtraEtcdMembers(tasksToRun)
+	}
+
+	if upgradeNeeded || opts.ForceUpgrade {
+		plan.Action = applyActionUpgrade
+
+		// disable case, we do this as early as possible.
+		if s.ShouldDisableEncryption() {
+			tasksToRun = tasks.WithDisableEncryptionProviders(tasksToRun, s.LiveCluster.EncryptionConfiguration.Custom)
+		}
+
+		tasksToRun = tasks.WithUpgrade(tasksToRun, s.Cluster.Followers(), s.Cluster.StaticWorkers)
+
+		if s.ShouldEnableEncryption() {
+			operations = append(operations, applyPlanOp{Sign: "~", Description: "enable Encryption Provider support", phase: tasks.PhaseEncryption})
+			tasksToRun = tasks.WithRewriteSecrets(tasksToRun)
+		}
+
+		// custom encryption configuration was modified
+		if s.LiveCluster.CustomEncryptionEnabled() &&
+			s.Cluster.Features.EncryptionProviders != nil &&
+			s.Cluster.Features.EncryptionProviders.CustomEncryptionConfiguration != "" {
+			config := &apiserverconfigv1.EncryptionConfiguration{}
+			err = kyaml.UnmarshalStrict([]byte(s.Cluster.Features.EncryptionProviders.CustomEncryptionConfiguration), config)
+			if err != nil {
+				return nil, err
+			}
+
+			if !reflect.DeepEqual(config, s.LiveCluster.EncryptionConfiguration.Config) {
+				operations = append(operations,
+					applyPlanOp{Sign: "~", Description: "update Encryption Provider configuration", phase: tasks.PhaseEncryption},
+					applyPlanOp{Sign: "~", Description: "restart KubeAPI", phase: tasks.PhaseEncryption},
+				)
+				tasksToRun = tasks.WithCustomEncryptionConfigUpdated(tasksToRun)
+			}
+		}
+
+		forceFlag := ""
+		if opts.ForceUpgrade {
+			forceFlag = "force "
+		}
+
+		for i, node := range s.LiveCluster.ControlPlane {
+			plan.Nodes[i].Action = "upgrade"
+			plan.Nodes[i].ToVersion = s.Cluster.Versions.Kubernetes
+			operations = append(operations, applyPlanOp{
+				Sign: "~",
+				Description: fmt.Sprintf("%supgrade control plane node %q (%s): %s -> %s",
+					forceFlag,
+					node.Config.Hostname,
+					node.Config.PrivateAddress,
+					node.Kubelet.Version,
+					s.Cluster.Versions.Kubernetes),
+				phase: tasks.PhaseControlPlane,
+			})
+		}
+
+		for i, node := range s.LiveCluster.StaticWorkers {
+			idx := len(s.LiveCluster.ControlPlane) + i
+			plan.Nodes[idx].Action = "upgrade"
+			plan.Nodes[idx].ToVersion = s.Cluster.Versions.Kubernetes
+			operations = append(operations, applyPlanOp{
+				Sign: "~",
+				Description: fmt.Sprintf("%supgrade worker node %q (%s): %s -> %s",
+					forceFlag,
+					node.Config.Hostname,
+					node.Config.PrivateAddress,
+					node.Kubelet.Version,
+					s.Cluster.Versions.Kubernetes),
+				phase: tasks.PhaseStaticWorkers,
+			})
+		}
+	} else {
+		plan.Action = applyActionReconcile
+		tasksToRun = tasks.WithResources(tasksToRun)
+	}
+
+	plan.Operations = append(plan.Operations, operations...)
+
+	return tasksToRun, nil
+}
+
+func modifyOps(descriptions []string) []applyPlanOp {
+	ops := make([]applyPlanOp, 0, len(descriptions))
+	for _, desc := range descriptions {
+		ops = append(ops, applyPlanOp{Sign: "~", Description: desc})
+	}
+
+	return ops
+}
+
+// selectedPhaseOps returns the operations of the phases selected by the
+// filter, preceded by the notice about the filter
+func selectedPhaseOps(ops []applyPlanOp, phases tasks.PhaseFilter) []applyPlanOp {
+	if phases.Empty() {
+		return ops
+	}
+
+	var selected []applyPlanOp
+
+	if len(phases.Only) > 0 {
+		selected = append(selected, applyPlanOp{Sign: "!", Description: fmt.Sprintf("only the %s phase(s) will be run", strings.Join(phases.Only, ", "))})
+	}
+
+	if len(phases.Skip) > 0 {
+		selected = append(selected, applyPlanOp{Sign: "!", Description: fmt.Sprintf("the %s phase(s) will be skipped", strings.Join(phases.Skip, ", "))})
+	}
+
+	for _, op := range ops {
+		if phases.Selected(op.phase) {
+			selected = append(selected, op)
+		}
+	}
+
+	return selected
+}
+
+// planNodes returns the probed state of all control plane nodes followed by
+// all static worker nodes.
+func planNodes(st *state.State) []applyPlanNode {
+	var nodes []applyPlanNode
+
+	newNode := func(host state.Host, role string) applyPlanNode {
+		return applyPlanNode{
+			Hostname:          host.Config.Hostname,
+			PublicAddress:     host.Config.PublicAddress,
+			PrivateAddress:    host.Config.PrivateAddress,
+			Role:              role,
+			Leader:            host.Config.IsLeader,
+			InCluster:         host.IsInCluster,
+			Initialized:       host.Initialized(),
+			KubeletStatus:     host.Kubelet.Status,
+			ContainerdStatus:  host.ContainerRuntimeContainerd.Status,
+			ContainerdVersion: versionString(host.ContainerRuntimeContainerd.Version),
+			APIServerStatus:   host.APIServer.Status,
+			EtcdStatus:        host.Etcd.Status,
+			Action:            "none",
+			FromVersion:       versionString(host.Kubelet.Version),
+		}
+	}
+
+	for _, host := range st.LiveCluster.ControlPlane {
+		nodes = append(nodes, newNode(host, nodeRoleControlPlane))
+	}
+
+	for _, host := range st.LiveCluster.StaticWorkers {
+		nodes = append(nodes, newNode(host, nodeRoleStaticWorker))
+	}
+
+	return nodes
+}
+
+func planAddons(st *state.State) ([]applyPlanAddon, []applyPlanHelmRels) {
+	var (
+		addonsPlan []applyPlanAddon
+		helmPlan   []applyPlanHelmRels
+	)
+
+	if st.Cluster.Addons.Enabled() && st.Cluster.Addons.Path != "" {
+		addonsPlan = append(addonsPlan, applyPlanAddon{Path: st.Cluster.Addons.Path, Action: "apply"})
+	}
+
+	for _, addon := range st.Cluster.Addons.DeclaredAddonsOnly() {
+		action := "apply"
+		if addon.Delete {
+			action = "delete"
+		}
+		addonsPlan = append(addonsPlan, applyPlanAddon{Name: addon.Name, Action: action})
+	}
+
+	for _, rel := range st.Cluster.Addons.OnlyHelmReleases() {
+		releaseName := rel.ReleaseName
+		if releaseName == "" {
+			releaseName = rel.Chart
+		}
+
+		helmPlan = append(helmPlan, applyPlanHelmRels{
+			ReleaseName: releaseName,
+			Namespace:   rel.Namespace,
+			Chart:       rel.Chart,
+			Version:     rel.Version,
+			Action:      "install-or-upgrade",
+		})
+	}
+
+	return addonsPlan, helmPlan
+}
+
+// probedStateFingerprint returns a checksum of the probed cluster state that
+// changes whenever the live cluster no longer matches the state the plan was
+// computed from.
+func probedStateFingerprint(st *state.State, nodes []applyPlanNode) (string, error) {
+	probed := struct {
+		Nodes                     []applyPlanNode
+		EncryptionProvidersEnable bool
+		EncryptionProvidersCustom bool
+	}{
+		Nodes: make([]applyPlanNode, 0, len(nodes)),
+	}
+
+	for _, node := range nodes {
+		// only probed fields are part of the fingerprint
+		node.Action = ""
+		node.ToVersion = ""
+		probed.Nodes = append(probed.Nodes, node)
+	}
+
+	if ec := st.LiveCluster.EncryptionConfiguration; ec != nil {
+		probed.EncryptionProvidersEnable = ec.Enable
+		probed.EncryptionProvidersCustom = ec.Custom
+	}
+
+	buf, err := json.Marshal(probed)
+	if err != nil {
+		return "", fail.Runtime(err, "marshalling probed cluster state")
+	}
+
+	sum := sha256.Sum256(buf)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func printApplyPlan(plan *applyPlan, verbose bool) {
+	fmt.Println("The following actions will be taken: ")
+	if !verbose {
+		fmt.Println("Run with --verbose flag for more information.")
+	}
+
+	fmt.Println()
+	for _, op := range plan.Operations {
+		fmt.Printf("\t%s %s\n", op.Sign, op.Description)
+	}
+	fmt.Println()
+}
+
+func writeApplyPlan(filename string, plan *applyPlan) error {
+	buf, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return fail.Runtime(err, "marshalling plan")
+	}
+
+	return fail.Runtime(os.WriteFile(filename, append(buf, '\n'), 0o600), "writing plan file")
+}
+
+func readApplyPlan(filename string) (*applyPlan, error) {
+	buf, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fail.Runtime(err, "reading plan file")
+	}
+
+	plan := &applyPlan{}
+	if err = json.Unmarshal(buf, plan); err != nil {
+		return nil, fail.Config(err, "unmarshalling plan file")
+	}
+
+	if plan.APIVersion != applyPlanAPIVersion || plan.Kind != applyPlanKind {
+		return nil, fail.NewConfigError("reading plan file", "unsupported plan %s/%s, expected %s/%s", plan.APIVersion, plan.Kind, applyPlanAPIVersion, applyPlanKind)
+	}
+
+	return plan, nil
+}
+
+// verifyApplyPlan returns an error if the saved plan doesn't match the plan
+// computed from the current cluster state.
+func verifyApplyPlan(saved, current *applyPlan) error {
+	const op = "verifying saved plan"
+
+	switch {
+	case saved.ClusterName != current.ClusterName:
+		return fail.NewConfigError(op, "plan is for cluster %q, but the manifest is for %q", saved.ClusterName, current.ClusterName)
+	case saved.Fingerprint != current.Fingerprint:
+		return fail.NewConfigError(op, "probed cluster state has changed since the plan was created")
+	case !reflect.DeepEqual(saved.Flags, current.Flags):
+		return fail.NewConfigError(op, "apply flags %+v don't match the plan flags %+v", current.Flags, saved.Flags)
+	case saved.Action != current.Action:
+		return fail.NewConfigError(op, "planned action %q doesn't match the current action %q", saved.Action, current.Action)
+	case saved.KubernetesVersion != current.KubernetesVersion:
+		return fail.NewConfigError(op, "planned Kubernetes version %q doesn't match the manifest version %q", saved.KubernetesVersion, current.KubernetesVersion)
+	case !reflect.DeepEqual(saved.Nodes, current.Nodes):
+		return fail.NewConfigError(op, "planned node actions don't match the current node actions")
+	case !reflect.DeepEqual(saved.Tasks, current.Tasks):
+		return fail.NewConfigError(op, "planned tasks don't match the current tasks")
+	case !reflect.DeepEqual(saved.Addons, current.Addons) || !reflect.DeepEqual(saved.HelmReleases, current.HelmReleases):
+		return fail.NewConfigError(op, "planned addons and helm releases don't match the manifest")
+	}
+
+	return nil
+}
+
+func versionString(version *semver.Version) string {
+	if version == nil {
+		return ""
+	}
+
+	return version.String()
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+func testApplyPlan() *applyPlan {
+	return &applyPlan{
+		APIVersion:        applyPlanAPIVersion,
+		Kind:              applyPlanKind,
+		ClusterName:       "test-cluster",
+		KubernetesVersion: "1.33.1",
+		Action:            applyActionUpgrade,
+		Fingerprint:       "abc",
+		Tasks:             []string{"upgrading 192.168.1.1 static worker node"},
+		Nodes: []applyPlanNode{
+			{
+				Hostname:    "worker-1",
+				Role:        nodeRoleStaticWorker,
+				InCluster:   true,
+				Initialized: true,
+				Action:      "upgrade",
+				FromVersion: "1.32.4",
+				ToVersion:   "1.33.1",
+			},
+		},
+	}
+}
+
+func TestVerifyApplyPlan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mutate  func(*applyPlan)
+		wantErr bool
+	}{
+		{
+			name:   "unchanged",
+			mutate: func(*applyPlan) {},
+		},
+		{
+			name:    "probed state changed",
+			mutate:  func(p *applyPlan) { p.Fingerprint = "def" },
+			wantErr: true,
+		},
+		{
+			name:    "different action",
+			mutate:  func(p *applyPlan) { p.Action = applyActionReconcile },
+			wantErr: true,
+		},
+		{
+			name:    "different flags",
+			mutate:  func(p *applyPlan) { p.Flags.ForceUpgrade = true },
+			wantErr: true,
+		},
+		{
+			name:    "different target version",
+			mutate:  func(p *applyPlan) { p.Nodes[0].ToVersion = "1.33.2" },
+			wantErr: true,
+		},
+		{
+			name:    "different phases",
+			mutate:  func(p *applyPlan) { p.Flags.SkipPhases = []string{"addons"} },
+			wantErr: true,
+		},
+		{
+			name:    "different tasks",
+			mutate:  func(p *applyPlan) { p.Tasks = nil },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			current := testApplyPlan()
+			tt.mutate(current)
+
+			err := verifyApplyPlan(testApplyPlan(), current)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifyApplyPlan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyPlanRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "plan.json")
+	want := testApplyPlan()
+
+	if err := writeApplyPlan(filename, want); err != nil {
+		t.Fatalf("writeApplyPlan() error = %v", err)
+	}
+
+	got, err := readApplyPlan(filename)
+	if err != nil {
+		t.Fatalf("readApplyPlan() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readApplyPlan() = %+v, want %+v", got, want)
+	}
+}
+
+// newPlanTestState returns the state of the cluster with the given control
+// plane nodes, targeting Kubernetes 1.33.1
+func newPlanTestState(controlPlane ...state.Host) *state.State {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return &state.State{
+		Cluster: &kubeoneapi.KubeOneCluster{
+			Name:                   "test-cluster",
+			Versions:               kubeoneapi.VersionConfig{Kubernetes: "1.33.1"},
+			OperatingSystemManager: &kubeoneapi.OperatingSystemManagerConfig{},
+		},
+		LiveCluster: &state.Cluster{
+			ExpectedKubernetesVersion: semver.MustParse("1.33.1"),
+			ControlPlane:              controlPlane,
+		},
+		Logger: logger,
+	}
+}
+
+// newPlanTestHost returns the control plane node, the initialized and healthy
+// one running the kubelet of the given version unless the version is empty
+func newPlanTestHost(hostname string, leader bool, kubeletVersion string) state.Host {
+	host := state.Host{Config: &kubeoneapi.HostConfig{Hostname: hostname, IsLeader: leader}}
+	if kubeletVersion == "" {
+		return host
+	}
+
+	host.IsInCluster = true
+	host.ContainerRuntimeContainerd = state.ComponentStatus{Status: state.ComponentInstalled | state.SystemDStatusRunning}
+	host.Kubelet = state.ComponentStatus{
+		Version: semver.MustParse(kubeletVersion),
+		Status:  state.ComponentInstalled | state.SystemDStatusRunning | state.KubeletInitialized,
+	}
+	host.APIServer = state.ContainerStatus{Status: state.PodRunning}
+	host.Etcd = state.ContainerStatus{Status: state.PodRunning}
+
+	return host
+}
+
+func TestBuildApplyPlanEtcdSnapshot(t *testing.T) {
+	t.Parallel()
+
+	const snapshotDescription = "save etcd snapshot next to the backup file"
+
+	tests := []struct {
+		name         string
+		controlPlane []state.Host
+		wantAction   applyAction
+		wantSnapshot bool
+	}{
+		{
+			name: "install",
+			controlPlane: []state.Host{
+				newPlanTestHost("cp-1", true, ""),
+				newPlanTestHost("cp-2", false, ""),
+			},
+			wantAction:   applyActionInstall,
+			wantSnapshot: false,
+		},
+		{
+			name: "repair",
+			controlPlane: []state.Host{
+				newPlanTestHost("cp-1", true, "1.33.1"),
+				newPlanTestHost("cp-2", false, ""),
+			},
+			wantAction:   applyActionRepair,
+			wantSnapshot: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, _, err := buildApplyPlan(newPlanTestState(tt.controlPlane...), &applyOpts{})
+			if err != nil {
+				t.Fatalf("buildApplyPlan() error = %v", err)
+			}
+
+			if plan.Action != tt.wantAction {
+				t.Errorf("buildApplyPlan() action = %q, want %q", plan.Action, tt.wantAction)
+			}
+
+			if got := slices.Contains(plan.Tasks, snapshotDescription); got != tt.wantSnapshot {
+				t.Errorf("buildApplyPlan() tasks %q, etcd snapshot = %t, want %t", plan.Tasks, got, tt.wantSnapshot)
+			}
+		})
+	}
+}
+
+func TestBuildApplyPlanPhaseFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		controlPlane []state.Host
+		opts         applyOpts
+	}{
+		{
+			name:         "skip control plane on upgrade",
+			controlPlane: []state.Host{newPlanTestHost("cp-1", true, "1.32.4")},
+			opts:         applyOpts{SkipPhases: []string{tasks.PhaseControlPlane}},
+		},
+		{
+			name:         "only addons on install",
+			controlPlane: []state.Host{newPlanTestHost("cp-1", true, "")},
+			opts:         applyOpts{OnlyPhases: []string{tasks.PhaseAddons}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := buildApplyPlan(newPlanTestState(tt.controlPlane...), &tt.opts)
+			if err == nil || !strings.Contains(err.Error(), "can be used only to reconcile") {
+				t.Errorf("buildApplyPlan() error = %v, want the phase filter rejected", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/confirmation"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type rollbackOpts struct {
+	globalOptions
+	AutoApprove bool   `longflag:"auto-approve" shortflag:"y"`
+	BackupFile  string `longflag:"backup" shortflag:"b"`
+	RestoreEtcd bool   `longflag:"restore-etcd"`
+}
+
+func (opts *rollbackOpts) BuildState() (*state.State, error) {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return nil, err
+	}
+
+	s.BackupFile = defaultBackupPath(opts.BackupFile, opts.ManifestFile, s.Cluster.Name)
+
+	record, err := state.LoadUpgradeRecord(state.UpgradeRecordPath(s.BackupFile, s.Cluster.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	s.UpgradeRecord = record
+	s.RestoreEtcd = opts.RestoreEtcd
+	s.Cluster.Versions.Kubernetes = record.KubernetesVersion
+
+	return s, nil
+}
+
+func rollbackCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &rollbackOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the failed upgrade",
+		Long: heredoc.Doc(`
+			Roll back the cluster to the Kubernetes version it ran before the last upgrade, using the state recorded by
+			'kubeone apply' before upgrading the leader control plane node.
+
+			The Kubernetes binaries, static pod manifests and kubelet configuration are reverted node by node, control plane
+			nodes first. Etcd is restored from the pre-upgrade snapshot only with '--restore-etcd', discarding all changes
+			made to the cluster since the snapshot was taken.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone rollback -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runRollback(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve rollback",
+	)
+
+	cmd.Flags().StringVarP(
+		&opts.BackupFile,
+		longFlagName(opts, "BackupFile"),
+		shortFlagName(opts, "BackupFile"),
+		"",
+		"path to the PKI backup .tar.gz file, the upgrade record and etcd snapshots are stored next to it (default: location of cluster config file)",
+	)
+
+	cmd.Flags().BoolVar(
+		&opts.RestoreEtcd,
+		longFlagName(opts, "RestoreEtcd"),
+		false,
+		"restore etcd from the snapshot taken before the upgrade (!dangerous!)",
+	)
+
+	return cmd
+}
+
+func runRollback(opts *rollbackOpts) error {
+	s, err := opts.BuildState()
+	if err != nil {
+		return err
+	}
+
+	record := s.UpgradeRecord
+
+	fmt.Printf("The upgrade from %s to %s started at %s will be rolled back:\n",
+		record.KubernetesVersion, record.TargetKubernetesVersion, record.Time.Local().Format("2006-01-02 15:04:05"))
+	for _, node := range s.Cluster.ControlPlane.Hosts {
+		fmt.Printf("\t- roll back control plane node %q (%s)\n", node.Hostname, node.PrivateAddress)
+	}
+	for _, node := range s.Cluster.StaticWorkers.Hosts {
+		fmt.Printf("\t- roll back static worker node %q (%s)\n", node.Hostname, node.PrivateAddress)
+	}
+
+	if opts.RestoreEtcd {
+		fmt.Printf("\nEtcd will be restored from %q, all changes made to the cluster since then will be LOST!\n", record.EtcdSnapshot)
+	}
+
+	approved, err := confirmation.Approved(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !approved {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	return tasks.WithRollback(nil).Run(s)
+}
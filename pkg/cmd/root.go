@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -24,6 +25,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/ssh"
+	"k8c.io/kubeone/pkg/tracing"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	apiextensionsscheme "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
@@ -51,7 +54,12 @@ func Execute() {
 
 	rootCmd := NewRoot()
 
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.Execute()
+	if shutdownErr := tracing.Shutdown(context.Background(), err); shutdownErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: flushing traces: %s\n", shutdownErr)
+	}
+
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
 		exitCode := fail.ExitCode(err)
 
@@ -77,6 +85,12 @@ func NewRoot() *cobra.Command {
 		Short:        "Kubernetes Cluster provisioning and maintaining tool",
 		Long:         "Provision and maintain Kubernetes High-Availability clusters with ease",
 		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			return tracing.Setup(cmd.Context(), cmd.CommandPath(), tracing.Options{
+				OTLPEndpoint: opts.TraceEndpoint,
+				File:         opts.TraceFile,
+			})
+		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return cmd.Help()
 		},
@@ -84,11 +98,11 @@ func NewRoot() *cobra.Command {
 
 	fs := rootCmd.PersistentFlags()
 
-	fs.StringVarP(&opts.ManifestFile,
-		longFlagName(opts, "ManifestFile"),
-		shortFlagName(opts, "ManifestFile"),
-		"./kubeone.yaml",
-		"Path to the KubeOne config")
+	fs.StringArrayVarP(&opts.ManifestFiles,
+		longFlagName(opts, "ManifestFiles"),
+		shortFlagName(opts, "ManifestFiles"),
+		[]string{"./kubeone.yaml"},
+		"Path to the KubeOne config. Repeat to merge the configs in order, each patching the previous ones, with the relative paths resolved from the config setting them")
 
 	fs.StringVarP(&opts.TerraformState,
 		longFlagName(opts, "TerraformState"),
@@ -120,6 +134,67 @@ func NewRoot() *cobra.Command {
 		"text",
 		"format for logging")
 
+	fs.IntVar(&opts.Concurrency,
+		longFlagName(opts, "Concurrency"),
+		0,
+		"maximum number of nodes to run the parallel tasks on at the same time (default: execution.concurrency from the KubeOne config, unlimited if not set)")
+
+	fs.IntVar(&opts.RetryAttempts,
+		longFlagName(opts, "RetryAttempts"),
+		0,
+		"maximum number of attempts of the failed tasks (default: execution.retry.attempts from the KubeOne config, 10 if not set)")
+
+	fs.DurationVar(&opts.RetryBackOff,
+		longFlagName(opts, "RetryBackOff"),
+		0,
+		"time to wait before retrying the failed task for the first time, growing 1.4 times after each attempt (default: execution.retry.initialBackOff from the KubeOne config, 10s if not set)")
+
+	fs.DurationVar(&opts.RetryMaxBackOff,
+		longFlagName(opts, "RetryMaxBackOff"),
+		0,
+		"maximum time to wait between the attempts of the failed task (default: execution.retry.maxBackOff from the KubeOne config, unlimited if not set)")
+
+	fs.StringVar(&opts.EventsFile,
+		longFlagName(opts, "EventsFile"),
+		"",
+		"write the task and node lifecycle events as JSON lines to the given file, or to the already opened file descriptor N with fd://N")
+
+	fs.StringVar(&opts.AuditLogFile,
+		longFlagName(opts, "AuditLogFile"),
+		"",
+		"append every command run on the hosts, with the host, start and end time, exit code and input and output sizes, as JSON lines to the given file. Join tokens, certificate keys, passwords and other secrets are redacted")
+
+	fs.StringVar(&opts.TraceEndpoint,
+		longFlagName(opts, "TraceEndpoint"),
+		"",
+		"export OpenTelemetry traces of the run to the OTLP/HTTP collector at the given URL, e.g. http://localhost:4318")
+
+	fs.StringVar(&opts.TraceFile,
+		longFlagName(opts, "TraceFile"),
+		"",
+		"write OpenTelemetry traces of the run to the given file as JSON")
+
+	fs.StringVar(&opts.SSHConfigFile,
+		longFlagName(opts, "SSHConfigFile"),
+		"",
+		"apply the Host blocks of the OpenSSH client config, e.g. ~/.ssh/config, to the SSH connections. HostName and ProxyJump of the jump hosts are always applied, User, Port, IdentityFile and ProxyJump only to the values left at their defaults in the KubeOne config")
+
+	fs.StringVar(&opts.HostKeyChecking,
+		longFlagName(opts, "HostKeyChecking"),
+		ssh.HostKeyCheckingAcceptNew,
+		fmt.Sprintf("verify the SSH host keys of the nodes and the bastions without the key in the KubeOne config against the known_hosts files: %q doesn't verify them, %q rejects the unknown keys and %q records them to %s on the first connection. Changed keys are always rejected",
+			ssh.HostKeyCheckingOff, ssh.HostKeyCheckingStrict, ssh.HostKeyCheckingAcceptNew, ssh.ManagedKnownHostsFile))
+
+	fs.StringSliceVar(&opts.KnownHostsFiles,
+		longFlagName(opts, "KnownHostsFiles"),
+		[]string{ssh.DefaultKnownHostsFile},
+		"known_hosts files to verify the SSH host keys against, in addition to "+ssh.ManagedKnownHostsFile)
+
+	fs.StringVar(&opts.PassphraseFile,
+		longFlagName(opts, "PassphraseFile"),
+		"",
+		fmt.Sprintf("file with the passphrase of the passphrase-protected SSH private keys. If not set, the passphrase is read from the %s environment variable, or asked for once for each key when running in the terminal", ssh.PassphraseEnv))
+
 	rootCmd.AddCommand(
 		addonsCmd(fs),
 		applyCmd(fs),
@@ -133,8 +208,11 @@ func NewRoot() *cobra.Command {
 		localCmd(fs),
 		migrateCmd(fs),
 		mirrorImagesCmd(fs),
+		planCmd(fs),
 		proxyCmd(fs),
 		resetCmd(fs),
+		rollbackCmd(fs),
+		sshKeyscanCmd(fs),
 		statusCmd(fs),
 		uiCmd(fs),
 		versionCmd(),
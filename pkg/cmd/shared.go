@@ -17,13 +17,14 @@ limitations under the License.
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/bombsimon/logrusr/v4"
 	"github.com/pkg/errors"
@@ -34,11 +35,17 @@ import (
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/apis/kubeone/config"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	"k8c.io/kubeone/pkg/audit"
 	"k8c.io/kubeone/pkg/credentials"
+	"k8c.io/kubeone/pkg/events"
+	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/ssh"
 	"k8c.io/kubeone/pkg/state"
 	"k8c.io/kubeone/pkg/templates/images"
+	"k8c.io/kubeone/pkg/tracing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimelog "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/yaml"
@@ -49,24 +56,58 @@ var defaultKubeVersion = ""
 const yes = "yes"
 
 type globalOptions struct {
-	ManifestFile    string `longflag:"manifest" shortflag:"m"`
-	TerraformState  string `longflag:"tfjson" shortflag:"t"`
-	CredentialsFile string `longflag:"credentials" shortflag:"c"`
-	Verbose         bool   `longflag:"verbose" shortflag:"v"`
-	Debug           bool   `longflag:"debug" shortflag:"d"`
-	LogFormat       string `longflag:"log-format" shortflag:"l"`
+	ManifestFiles   []string      `longflag:"manifest" shortflag:"m"`
+	TerraformState  string        `longflag:"tfjson" shortflag:"t"`
+	CredentialsFile string        `longflag:"credentials" shortflag:"c"`
+	Verbose         bool          `longflag:"verbose" shortflag:"v"`
+	Debug           bool          `longflag:"debug" shortflag:"d"`
+	LogFormat       string        `longflag:"log-format" shortflag:"l"`
+	Concurrency     int           `longflag:"concurrency"`
+	RetryAttempts   int           `longflag:"retry-attempts"`
+	RetryBackOff    time.Duration `longflag:"retry-backoff"`
+	RetryMaxBackOff time.Duration `longflag:"retry-max-backoff"`
+	EventsFile      string        `longflag:"events-file"`
+	AuditLogFile    string        `longflag:"audit-log"`
+	TraceEndpoint   string        `longflag:"trace-otlp-endpoint"`
+	TraceFile       string        `longflag:"trace-file"`
+	SSHConfigFile   string        `longflag:"ssh-config"`
+	HostKeyChecking string        `longflag:"ssh-host-key-checking"`
+	KnownHostsFiles []string      `longflag:"ssh-known-hosts"`
+	PassphraseFile  string        `longflag:"ssh-key-passphrase-file"`
+
+	// ManifestFile is the last of the ManifestFiles, the relative paths are
+	// resolved from
+	ManifestFile string
 }
 
 func (opts *globalOptions) BuildState() (*state.State, error) {
-	rootContext := context.Background()
-	s, err := state.New(rootContext)
+	logger := newLogger(opts.Verbose, opts.LogFormat)
+	graceful, abort := signalContexts(logger)
+
+	if opts.HostKeyChecking == ssh.HostKeyCheckingOff {
+		logger.Warnf("SSH host key checking is OFF, the host keys not set in the KubeOne config are NOT verified and the connections can be intercepted; "+
+			"use '--%s=%s' unless the network path to the hosts can be trusted", longFlagName(opts, "HostKeyChecking"), ssh.HostKeyCheckingAcceptNew)
+	}
+
+	connectorOpts, err := opts.connectorOptions()
 	if err != nil {
 		return nil, err
 	}
 
-	s.Logger = newLogger(opts.Verbose, opts.LogFormat)
+	adapter, err := opts.executorAdapter(ssh.NewConnector(abort, connectorOpts...))
+	if err != nil {
+		return nil, err
+	}
 
-	cluster, err := loadClusterConfig(opts.ManifestFile, opts.TerraformState, opts.CredentialsFile, s.Logger)
+	s, err := state.New(tracing.WithRootSpan(graceful), state.WithExecutorAdapter(adapter))
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger = logger
+	s.AbortContext = abort
+
+	cluster, err := loadClusterConfig(opts.ManifestFiles, opts.TerraformState, opts.CredentialsFile, s.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +116,12 @@ func (opts *globalOptions) BuildState() (*state.State, error) {
 	s.ManifestFilePath = opts.ManifestFile
 	s.CredentialsFilePath = opts.CredentialsFile
 	s.Verbose = opts.Verbose
+	s.Concurrency = concurrency(opts.Concurrency, cluster)
+	s.Retry = opts.retryPolicy(cluster)
+
+	if s.Events, err = opts.openEvents(); err != nil {
+		return nil, err
+	}
 
 	// Validate Addons path if provided
 	if s.Cluster.Addons.Enabled() {
@@ -121,11 +168,14 @@ func shortFlagName(obj any, fieldName string) string {
 func persistentGlobalOptions(fs *pflag.FlagSet) (*globalOptions, error) {
 	gf := &globalOptions{}
 
-	manifestFile, err := fs.GetString(longFlagName(gf, "ManifestFile"))
+	manifestFiles, err := fs.GetStringArray(longFlagName(gf, "ManifestFiles"))
 	if err != nil {
 		return nil, fail.Runtime(err, "getting global flags")
 	}
-	gf.ManifestFile = manifestFile
+	gf.ManifestFiles = manifestFiles
+	if len(manifestFiles) > 0 {
+		gf.ManifestFile = manifestFiles[len(manifestFiles)-1]
+	}
 
 	verbose, err := fs.GetBool(longFlagName(gf, "Verbose"))
 	if err != nil {
@@ -151,9 +201,159 @@ func persistentGlobalOptions(fs *pflag.FlagSet) (*globalOptions, error) {
 	}
 	gf.LogFormat = logFormat
 
+	concurrency, err := fs.GetInt(longFlagName(gf, "Concurrency"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.Concurrency = concurrency
+
+	retryAttempts, err := fs.GetInt(longFlagName(gf, "RetryAttempts"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.RetryAttempts = retryAttempts
+
+	retryBackOff, err := fs.GetDuration(longFlagName(gf, "RetryBackOff"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.RetryBackOff = retryBackOff
+
+	retryMaxBackOff, err := fs.GetDuration(longFlagName(gf, "RetryMaxBackOff"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.RetryMaxBackOff = retryMaxBackOff
+
+	eventsFile, err := fs.GetString(longFlagName(gf, "EventsFile"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.EventsFile = eventsFile
+
+	auditLogFile, err := fs.GetString(longFlagName(gf, "AuditLogFile"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.AuditLogFile = auditLogFile
+
+	sshConfigFile, err := fs.GetString(longFlagName(gf, "SSHConfigFile"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.SSHConfigFile = sshConfigFile
+
+	hostKeyChecking, err := fs.GetString(longFlagName(gf, "HostKeyChecking"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.HostKeyChecking = hostKeyChecking
+
+	knownHostsFiles, err := fs.GetStringSlice(longFlagName(gf, "KnownHostsFiles"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.KnownHostsFiles = knownHostsFiles
+
+	passphraseFile, err := fs.GetString(longFlagName(gf, "PassphraseFile"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.PassphraseFile = passphraseFile
+
 	return gf, nil
 }
 
+// connectorOptions returns the options of the SSH connector, applying the
+// OpenSSH client config and verifying the host keys if requested, and
+// decrypting the passphrase-protected private keys
+func (opts *globalOptions) connectorOptions() ([]ssh.ConnectorOption, error) {
+	var connectorOpts []ssh.ConnectorOption
+
+	if opts.SSHConfigFile != "" {
+		userConfig, err := ssh.LoadUserConfig(opts.SSHConfigFile)
+		if err != nil {
+			return nil, err
+		}
+
+		connectorOpts = append(connectorOpts, ssh.WithUserConfig(userConfig))
+	}
+
+	if opts.HostKeyChecking != "" && opts.HostKeyChecking != ssh.HostKeyCheckingOff {
+		verifier, err := ssh.NewHostKeyVerifier(opts.HostKeyChecking, opts.KnownHostsFiles, ssh.ManagedKnownHostsFile)
+		if err != nil {
+			return nil, err
+		}
+
+		connectorOpts = append(connectorOpts, ssh.WithHostKeyVerifier(verifier))
+	}
+
+	passphrases, err := ssh.NewPassphrases(opts.PassphraseFile)
+	if err != nil {
+		return nil, err
+	}
+
+	connectorOpts = append(connectorOpts, ssh.WithPassphrases(passphrases))
+
+	return connectorOpts, nil
+}
+
+// executorAdapter wraps the executor adapter to run the commands with the
+// privilege escalation configured for the hosts, and to record them to the
+// audit log if requested
+func (opts *globalOptions) executorAdapter(adapter executor.Adapter) (executor.Adapter, error) {
+	adapter = executor.WithPrivilegeEscalation(adapter)
+
+	if opts.AuditLogFile == "" {
+		return adapter, nil
+	}
+
+	log, err := audit.Open(opts.AuditLogFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return audit.WrapAdapter(adapter, log), nil
+}
+
+// openEvents opens the events stream if requested, nil stream discards the
+// events otherwise
+func (opts *globalOptions) openEvents() (*events.Stream, error) {
+	if opts.EventsFile == "" {
+		return nil, nil
+	}
+
+	return events.Open(opts.EventsFile)
+}
+
+// concurrency returns the limit of nodes worked on at the same time, the flag
+// takes precedence over the manifest
+func concurrency(flag int, cluster *kubeoneapi.KubeOneCluster) int {
+	if flag > 0 {
+		return flag
+	}
+
+	return cluster.Execution.Concurrency
+}
+
+// retryPolicy returns the retry policy of the tasks, the flags take precedence
+// over the manifest
+func (opts *globalOptions) retryPolicy(cluster *kubeoneapi.KubeOneCluster) kubeoneapi.RetryPolicy {
+	policy := cluster.Execution.Retry
+
+	if opts.RetryAttempts > 0 {
+		policy.Attempts = opts.RetryAttempts
+	}
+	if opts.RetryBackOff > 0 {
+		policy.InitialBackOff = metav1.Duration{Duration: opts.RetryBackOff}
+	}
+	if opts.RetryMaxBackOff > 0 {
+		policy.MaxBackOff = metav1.Duration{Duration: opts.RetryMaxBackOff}
+	}
+
+	return policy
+}
+
 func newLogger(verbose bool, format string) *logrus.Logger {
 	logger := logrus.New()
 
@@ -178,8 +378,8 @@ func newLogger(verbose bool, format string) *logrus.Logger {
 	return logger
 }
 
-func loadClusterConfig(filename, terraformOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
-	cls, err := config.LoadKubeOneCluster(filename, terraformOutputPath, credentialsFilePath, logger)
+func loadClusterConfig(filenames []string, terraformOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
+	cls, err := config.LoadKubeOneCluster(filenames, terraformOutputPath, credentialsFilePath, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -243,10 +443,16 @@ func defaultBackupPath(backupPath, manifestPath, clusterName string) string {
 	return backupPath
 }
 
-func newImageResolver(kubernetesVersion, manifestFile string) (*images.Resolver, error) {
+func newImageResolver(kubernetesVersion string, manifestFiles []string) (*images.Resolver, error) {
 	var resolveropts []images.Opt
 
-	configBuf, configErr := os.ReadFile(manifestFile)
+	// the missing manifest is not an error, the images are listed for the
+	// --kubernetes-version then
+	configBuf, _, configErr := config.ReadManifests(manifestFiles)
+	if configErr != nil && !errors.Is(configErr, fs.ErrNotExist) {
+		return nil, configErr
+	}
+
 	if configErr == nil {
 		var conf kubeonev1beta2.KubeOneCluster
 		if err := yaml.Unmarshal(configBuf, &conf); err != nil {
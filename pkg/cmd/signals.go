@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+)
+
+// signalContexts returns two contexts canceled on the interrupt. The graceful
+// context is canceled on the first SIGINT/SIGTERM, letting the current step
+// finish before stopping the run. The abort context is canceled on the second
+// signal and kills the commands still running on the hosts.
+func signalContexts(logger logrus.FieldLogger) (graceful, abort context.Context) {
+	graceful, cancelGraceful := context.WithCancel(context.Background())
+	abort, cancelAbort := context.WithCancel(context.Background())
+
+	sigCh := make(chan os.Signal, 2)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		<-sigCh
+		logger.Warnln("Interrupted, stopping after the current step finishes, interrupt again to abort immediately")
+		cancelGraceful()
+
+		<-sigCh
+		logger.Warnln("Aborting")
+		cancelAbort()
+
+		// let the third signal terminate the process right away
+		signal.Stop(sigCh)
+	}()
+
+	return graceful, abort
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v2"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	kubeonessh "k8c.io/kubeone/pkg/ssh"
+	"k8c.io/kubeone/pkg/yamled"
+)
+
+type sshKeyscanOpts struct {
+	globalOptions
+	Write bool `longflag:"write" shortflag:"w"`
+}
+
+func sshKeyscanCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &sshKeyscanOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "ssh-keyscan",
+		Short: "Write the SSH host keys of the nodes and the bastions into the manifest",
+		Long: heredoc.Doc(`
+			Connect to the control plane and static worker nodes, and their bastions and jump hosts, and set the presented
+			SSH host keys in the manifest (sshHostPublicKey, bastionHostPublicKey and jumpHosts[].hostPublicKey), so they
+			are verified on every following connection.
+
+			The host keys are NOT verified while scanning, so make sure the network path to the hosts can be trusted.
+
+			The updated manifest is printed to the stdout, or written back to the manifest file with '--write'. Comments
+			in the manifest are not preserved. Host keys of the hosts not listed in the manifest, e.g. those from the
+			Terraform output, can't be recorded; the default '--ssh-host-key-checking=accept-new' records them to
+			~/.kubeone/known_hosts on the first connection instead. With multiple '--manifest' flags, only the last
+			manifest, and the hosts listed in it, are updated.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone ssh-keyscan -m mycluster.yaml -t terraformoutput.json --write`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runSSHKeyscan(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.Write,
+		longFlagName(opts, "Write"),
+		shortFlagName(opts, "Write"),
+		false,
+		"write the updated manifest back to the manifest file instead of printing it")
+
+	return cmd
+}
+
+func runSSHKeyscan(opts *sshKeyscanOpts) error {
+	s, err := opts.BuildState()
+	if err != nil {
+		return err
+	}
+
+	connectorOpts, err := opts.connectorOptions()
+	if err != nil {
+		return err
+	}
+
+	connector := kubeonessh.NewConnector(s.Context, connectorOpts...)
+
+	hostKeys := map[string]*kubeonessh.HostKeys{}
+	hosts := append(append([]kubeoneapi.HostConfig{}, s.Cluster.ControlPlane.Hosts...), s.Cluster.StaticWorkers.Hosts...)
+
+	for _, host := range hosts {
+		keys, scanErr := connector.ScanHostKeys(host)
+		if scanErr != nil {
+			return scanErr
+		}
+
+		for i, jumpKey := range keys.JumpHosts {
+			logHostKey(s.Logger.WithField("node", host.PublicAddress).WithField("jump", i), jumpKey)
+		}
+		logHostKey(s.Logger.WithField("node", host.PublicAddress), keys.Host)
+
+		hostKeys[host.PublicAddress] = keys
+	}
+
+	manifest, err := loadManifestDocument(opts.ManifestFile)
+	if err != nil {
+		return err
+	}
+
+	recorded := map[string]bool{}
+	for _, hostsPath := range []yamled.Path{{"controlPlane", "hosts"}, {"staticWorkers", "hosts"}} {
+		items, _ := manifest.GetArray(hostsPath)
+
+		for i := range items {
+			hostPath := append(append(yamled.Path{}, hostsPath...), i)
+
+			address, _ := manifest.GetString(append(hostPath, "publicAddress"))
+			if keys, ok := hostKeys[address]; ok {
+				setManifestHostKeys(manifest, hostPath, keys)
+				recorded[address] = true
+			}
+		}
+	}
+
+	for _, host := range hosts {
+		if !recorded[host.PublicAddress] {
+			s.Logger.Warnf("Host %s is not listed in the manifest, its host keys are not recorded", host.PublicAddress)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = yaml.NewEncoder(&buf).Encode(manifest); err != nil {
+		return fail.Runtime(err, "marshalling manifest as YAML")
+	}
+
+	if !opts.Write {
+		_, err = os.Stdout.Write(buf.Bytes())
+
+		return fail.Runtime(err, "printing manifest")
+	}
+
+	info, err := os.Stat(opts.ManifestFile)
+	if err != nil {
+		return fail.Runtime(err, "checking manifest file")
+	}
+
+	if err = os.WriteFile(opts.ManifestFile, buf.Bytes(), info.Mode().Perm()); err != nil {
+		return fail.Runtime(err, "writing manifest file")
+	}
+
+	s.Logger.Infof("Host keys written to %q", opts.ManifestFile)
+
+	return nil
+}
+
+func loadManifestDocument(path string) (*yamled.Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fail.Runtime(err, "opening manifest file")
+	}
+	defer f.Close()
+
+	doc, err := yamled.Load(f)
+	if err != nil {
+		return nil, fail.Config(err, "parsing manifest file")
+	}
+
+	return doc, nil
+}
+
+// setManifestHostKeys sets the host keys of the host, and of its bastion or
+// jump hosts if they are set in the manifest
+func setManifestHostKeys(manifest *yamled.Document, hostPath yamled.Path, keys *kubeonessh.HostKeys) {
+	field := func(name ...yamled.Step) yamled.Path {
+		return append(append(yamled.Path{}, hostPath...), name...)
+	}
+
+	manifest.Set(field("sshHostPublicKey"), base64.StdEncoding.EncodeToString(keys.Host))
+
+	if bastion, _ := manifest.GetString(field("bastion")); bastion != "" && len(keys.JumpHosts) > 0 {
+		manifest.Set(field("bastionHostPublicKey"), base64.StdEncoding.EncodeToString(keys.JumpHosts[len(keys.JumpHosts)-1]))
+	}
+
+	// the jump hosts from the SSH config can precede those from the manifest
+	jumpHosts, _ := manifest.GetArray(field("jumpHosts"))
+	offset := len(keys.JumpHosts) - len(jumpHosts)
+
+	for i := range jumpHosts {
+		if offset+i < 0 {
+			continue
+		}
+
+		manifest.Set(field("jumpHosts", i, "hostPublicKey"), base64.StdEncoding.EncodeToString(keys.JumpHosts[offset+i]))
+	}
+}
+
+func logHostKey(logger logrus.FieldLogger, key []byte) {
+	publicKey, err := ssh.ParsePublicKey(key)
+	if err != nil {
+		return
+	}
+
+	logger.Infof("Host key %s %s", publicKey.Type(), ssh.FingerprintSHA256(publicKey))
+}
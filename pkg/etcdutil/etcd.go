@@ -20,6 +20,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"io/fs"
 	"time"
 
@@ -51,6 +52,28 @@ func NewClient(s *state.State) (*clientv3.Client, error) {
 	return cli, fail.Etcd(err, "creating etcd client")
 }
 
+// Snapshot writes a point-in-time snapshot of the etcd cluster to the writer
+// and returns the version of etcd which has taken it.
+func Snapshot(s *state.State, w io.Writer) (string, error) {
+	etcdcli, err := NewClient(s)
+	if err != nil {
+		return "", err
+	}
+	defer etcdcli.Close()
+
+	snapshotResp, err := clientv3.NewMaintenance(etcdcli).SnapshotWithVersion(s.Context)
+	if err != nil {
+		return "", fail.Etcd(err, "requesting snapshot")
+	}
+	defer snapshotResp.Snapshot.Close()
+
+	if _, err = io.Copy(w, snapshotResp.Snapshot); err != nil {
+		return "", fail.Etcd(err, "receiving snapshot")
+	}
+
+	return snapshotResp.Version, nil
+}
+
 // NewClientConfig returns etcd clientv3 Config configured with TLS certificates
 // and tunneled over SSH
 func NewClientConfig(s *state.State, host kubeoneapi.HostConfig) (*clientv3.Config, error) {
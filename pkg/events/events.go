@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events implements the structured stream of the task and node
+// lifecycle events, written as JSON lines for the external tooling to follow
+// the progress of the run.
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// APIVersion is the version of the events format. Fields are only ever added
+// within the same version, removing or changing the meaning of a field
+// requires a new version.
+const APIVersion = "events.kubeone.k8c.io/v1"
+
+// fdPrefix selects the already opened file descriptor instead of the file
+const fdPrefix = "fd://"
+
+type Type string
+
+const (
+	TaskStarted   Type = "TaskStarted"
+	TaskRetried   Type = "TaskRetried"
+	TaskFailed    Type = "TaskFailed"
+	TaskSucceeded Type = "TaskSucceeded"
+	TaskSkipped   Type = "TaskSkipped"
+	NodeStarted   Type = "NodeStarted"
+	NodeFailed    Type = "NodeFailed"
+	NodeSucceeded Type = "NodeSucceeded"
+	NodeSkipped   Type = "NodeSkipped"
+)
+
+// Event is a single line of the events stream
+type Event struct {
+	APIVersion string    `json:"apiVersion"`
+	Time       time.Time `json:"time"`
+	Type       Type      `json:"type"`
+	// Task is the operation of the task the event belongs to
+	Task string `json:"task,omitempty"`
+	// Description is the human readable description of the task
+	Description string `json:"description,omitempty"`
+	// Node is the public address of the node, set for the Node* events
+	Node string `json:"node,omitempty"`
+	// Attempt is the number of the attempt, starting at 1
+	Attempt int `json:"attempt,omitempty"`
+	// DurationSeconds is the time the task or node took, set for the
+	// finished tasks and nodes
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+	// Error is the error message of the failed (or retried) attempt
+	Error string `json:"error,omitempty"`
+	// ErrorType is the type of the pkg/fail error, e.g. SSHError
+	ErrorType string `json:"errorType,omitempty"`
+	// ExitCode is the exit code KubeOne would exit with because of the error
+	ExitCode int `json:"exitCode,omitempty"`
+	// Reason explains why the task or node has been skipped
+	Reason string `json:"reason,omitempty"`
+}
+
+// WithType returns the copy of the event with the given type
+func (e Event) WithType(typ Type) Event {
+	e.Type = typ
+
+	return e
+}
+
+// WithError fills the error fields of the event
+func (e Event) WithError(err error) Event {
+	if err == nil {
+		return e
+	}
+
+	e.Error = err.Error()
+	e.ErrorType = fail.ErrorType(err)
+	e.ExitCode = fail.ExitCode(err)
+
+	return e
+}
+
+// WithDuration fills the duration of the event since the given start
+func (e Event) WithDuration(start time.Time) Event {
+	e.DurationSeconds = time.Since(start).Seconds()
+
+	return e
+}
+
+// Stream writes events as JSON lines. A nil *Stream is valid and discards
+// all events.
+type Stream struct {
+	lock sync.Mutex
+	enc  *json.Encoder
+	now  func() time.Time
+}
+
+// New creates a stream writing to the given writer
+func New(w io.Writer) *Stream {
+	return &Stream{
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+}
+
+// Open creates a stream writing to the given file, truncating it, or to the
+// already opened file descriptor if target is in the fd://N form.
+func Open(target string) (*Stream, error) {
+	if fd, ok := strings.CutPrefix(target, fdPrefix); ok {
+		num, err := strconv.ParseUint(fd, 10, 0)
+		if err != nil {
+			return nil, fail.Config(err, "parsing events file descriptor")
+		}
+
+		return New(os.NewFile(uintptr(num), target)), nil
+	}
+
+	f, err := os.Create(target)
+	if err != nil {
+		return nil, fail.Runtime(err, "opening events file")
+	}
+
+	return New(f), nil
+}
+
+// Emit writes the event to the stream. Failing to write the event doesn't
+// fail the run.
+func (s *Stream) Emit(ev Event) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ev.APIVersion = APIVersion
+	ev.Time = s.now().UTC()
+
+	_ = s.enc.Encode(ev)
+}
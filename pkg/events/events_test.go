@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+func TestStreamEmit(t *testing.T) {
+	var buf bytes.Buffer
+
+	stream := New(&buf)
+	stream.now = func() time.Time {
+		return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	stream.Emit(Event{Type: TaskStarted, Task: "install", Attempt: 1})
+	stream.Emit(Event{Type: NodeFailed, Task: "install", Node: "192.168.1.1"}.WithError(fail.SSH(errors.New("boom"), "dialing")))
+
+	want := `{"apiVersion":"events.kubeone.k8c.io/v1","time":"2026-01-02T03:04:05Z","type":"TaskStarted","task":"install","attempt":1}
+{"apiVersion":"events.kubeone.k8c.io/v1","time":"2026-01-02T03:04:05Z","type":"NodeFailed","task":"install","node":"192.168.1.1","error":"ssh: dialing\nboom","errorType":"SSHError","exitCode":13}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Emit() wrote\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestNilStreamEmit(t *testing.T) {
+	var stream *Stream
+
+	// must not panic
+	stream.Emit(Event{Type: TaskStarted})
+}
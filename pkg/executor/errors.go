@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"os/exec"
+
+	"github.com/pkg/errors"
+)
+
+// IsExitError returns true if the command has run, but exited with the
+// non-zero exit code, as opposed to the failure of the connection it was run
+// over. The connection stays usable after the exit error.
+func IsExitError(err error) bool {
+	var (
+		localErr  *exec.ExitError
+		remoteErr interface{ ExitStatus() int }
+	)
+
+	return errors.As(err, &localErr) || errors.As(err, &remoteErr)
+}
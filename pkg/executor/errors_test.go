@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+func TestIsExitError(t *testing.T) {
+	conn, err := NewLocal(context.Background()).Open(kubeoneapi.HostConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, _, localErr := conn.Exec("exit 3")
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "local exit error",
+			err:  localErr,
+			want: true,
+		},
+		{
+			name: "remote exit error",
+			err:  fail.SSHError{Op: "exec", Err: fail.SSH(&ssh.ExitError{}, "popen")},
+			want: true,
+		},
+		{
+			name: "exit status missing",
+			err:  fail.SSH(&ssh.ExitMissingError{}, "popen"),
+			want: false,
+		},
+		{
+			name: "connection closed",
+			err:  fail.SSH(errors.New("connection closed"), "session"),
+			want: false,
+		},
+		{
+			name: "no error",
+			err:  nil,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExitError(tt.err); got != tt.want {
+				t.Errorf("IsExitError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -29,8 +29,17 @@ import (
 
 var _ executor.MkdirFS = &virtfs{}
 
+// New returns the file system of the host. Files are accessed over SFTP if
+// the SFTP server can be started on the host, otherwise by running the shell
+// commands.
 func New(conn executor.Interface) executor.MkdirFS {
-	return &virtfs{conn: conn}
+	shell := &virtfs{conn: conn}
+
+	if client := sftpClientFor(conn); client != nil {
+		return &sftpfs{client: client, shell: shell}
+	}
+
+	return shell
 }
 
 type virtfs struct {
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executorfs
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pkg/sftp"
+
+	"k8c.io/kubeone/pkg/executor"
+)
+
+// startSFTPServerCmd starts the SFTP server binary of the sshd with sudo, so
+// the files are accessed with the same privileges as by the shell commands.
+// The SFTP subsystem itself can't be used because it runs as the SSH user.
+// The binary configured as the sftp subsystem is preferred, followed by the
+// default locations of the popular distributions.
+const startSFTPServerCmd = `sudo -n sh -c '` +
+	`for p in "$(sed -n "s/^[Ss]ubsystem[[:space:]]*sftp[[:space:]]*\([^[:space:]]*\).*/\1/p" /etc/ssh/sshd_config 2>/dev/null)" ` +
+	`/usr/lib/openssh/sftp-server /usr/libexec/openssh/sftp-server /usr/lib/ssh/sftp-server /usr/libexec/sftp-server /usr/lib/sftp-server; ` +
+	`do if [ -x "$p" ]; then exec "$p"; fi; done; exit 127'`
+
+// IsSFTPServerCommand returns true if the command starts the SFTP server, e.g.
+// for the executors recording the commands to refuse it, so the files are
+// accessed with the shell commands instead
+func IsSFTPServerCommand(cmd string) bool {
+	return strings.HasSuffix(cmd, startSFTPServerCmd)
+}
+
+// sftpInitTimeout is the time to wait for the SFTP server to start
+const sftpInitTimeout = 10 * time.Second
+
+// sftpClients caches the SFTP clients of the connections. The nil client
+// records the connection the SFTP server can't be started over, so it's not
+// tried again. The entries with the SFTP client are removed once the server
+// exits, e.g. when the connection is closed, so the next client is started
+// over the new connection.
+var sftpClients = struct {
+	sync.Mutex
+	entries map[executor.Interface]*sftpEntry
+}{entries: map[executor.Interface]*sftpEntry{}}
+
+type sftpEntry struct {
+	once   sync.Once
+	client *sftp.Client
+}
+
+// sftpClientFor returns the SFTP client of the connection, starting the SFTP
+// server if needed, or nil if the SFTP server can't be started.
+func sftpClientFor(conn executor.Interface) *sftp.Client {
+	sftpClients.Lock()
+	entry, ok := sftpClients.entries[conn]
+	if !ok {
+		entry = &sftpEntry{}
+		sftpClients.entries[conn] = entry
+	}
+	sftpClients.Unlock()
+
+	entry.once.Do(func() {
+		entry.client = startSFTPClient(conn)
+		if entry.client == nil {
+			return
+		}
+
+		go func() {
+			_ = entry.client.Wait()
+
+			sftpClients.Lock()
+			defer sftpClients.Unlock()
+
+			if sftpClients.entries[conn] == entry {
+				delete(sftpClients.entries, conn)
+			}
+		}()
+	})
+
+	return entry.client
+}
+
+// startSFTPClient starts the SFTP server over the connection and initializes
+// the session, or returns nil if the server can't be started
+func startSFTPClient(conn executor.Interface) *sftp.Client {
+	stdinR, stdinW := io.Pipe()
+	stdoutR, stdoutW := io.Pipe()
+
+	go func() {
+		var stderr strings.Builder
+
+		_, err := conn.POpen(startSFTPServerCmd, stdinR, stdoutW, &stderr)
+		if err == nil {
+			err = io.EOF
+		}
+
+		stdoutW.CloseWithError(err)
+		stdinR.CloseWithError(err)
+	}()
+
+	// unblock reading the reply if the server doesn't start in time
+	timer := time.AfterFunc(sftpInitTimeout, func() {
+		err := errors.New("timeout waiting for the SFTP server")
+		stdinW.CloseWithError(err)
+		stdoutR.CloseWithError(err)
+	})
+
+	client, err := sftp.NewClientPipe(stdoutR, stdinW)
+	timer.Stop()
+
+	if err != nil {
+		stdinW.CloseWithError(err)
+		stdoutR.CloseWithError(err)
+
+		return nil
+	}
+
+	return client
+}
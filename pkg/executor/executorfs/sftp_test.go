@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executorfs
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pkg/sftp"
+)
+
+// fakeConn records the commands, all of them fail
+type fakeConn struct {
+	lock     sync.Mutex
+	commands []string
+}
+
+func (c *fakeConn) Exec(cmd string) (string, string, int, error) {
+	_, err := c.POpen(cmd, nil, io.Discard, io.Discard)
+
+	return "", "", 1, err
+}
+
+func (c *fakeConn) POpen(cmd string, stdin io.Reader, _, stderr io.Writer) (int, error) {
+	c.lock.Lock()
+	c.commands = append(c.commands, cmd)
+	c.lock.Unlock()
+
+	_, _ = io.WriteString(stderr, "command not found")
+
+	return 127, errors.New("exit status 127")
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+// sftpConn runs the in-test SFTP server backed by the local file system
+// instead of the SFTP server binary
+type sftpConn struct {
+	fakeConn
+}
+
+func (c *sftpConn) POpen(cmd string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	if cmd != startSFTPServerCmd {
+		return c.fakeConn.POpen(cmd, stdin, stdout, stderr)
+	}
+
+	c.lock.Lock()
+	c.commands = append(c.commands, cmd)
+	c.lock.Unlock()
+
+	serveSFTP(stdin, stdout)
+
+	return 0, nil
+}
+
+// sftpServerPipe is the stdin and the stdout of the SFTP server
+type sftpServerPipe struct {
+	io.Reader
+	io.Writer
+}
+
+func (sftpServerPipe) Close() error { return nil }
+
+// serveSFTP serves the SFTP requests until stdin is closed
+func serveSFTP(stdin io.Reader, stdout io.Writer) {
+	server, err := sftp.NewServer(sftpServerPipe{Reader: stdin, Writer: stdout})
+	if err != nil {
+		return
+	}
+
+	_ = server.Serve()
+}
+
+func TestSFTPFileSystem(t *testing.T) {
+	conn := &sftpConn{}
+
+	fsys, ok := New(conn).(*sftpfs)
+	if !ok {
+		t.Fatal("New() didn't return the SFTP file system")
+	}
+	defer fsys.client.Close()
+
+	dir := filepath.Join(t.TempDir(), "etc", "kubeone")
+	if err := fsys.MkdirAll(dir, 0o700); err != nil {
+		t.Fatalf("MkdirAll() = %v", err)
+	}
+
+	if err := fsys.MkdirAll(dir, 0o700); err != nil {
+		t.Fatalf("MkdirAll() of the existing directory = %v", err)
+	}
+
+	name := filepath.Join(dir, "config")
+
+	f, err := fsys.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := f.(*sftpfile)
+	if _, err = file.Write([]byte("hello world")); err != nil {
+		t.Fatalf("Write() = %v", err)
+	}
+
+	if err = file.Truncate(5); err != nil {
+		t.Fatalf("Truncate() = %v", err)
+	}
+
+	if err = file.Chmod(0o600); err != nil {
+		t.Fatalf("Chmod() = %v", err)
+	}
+
+	if err = file.Close(); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+
+	fi, err := file.Stat()
+	if err != nil {
+		t.Fatalf("Stat() = %v", err)
+	}
+
+	if fi.Size() != 5 || fi.Mode() != 0o600 {
+		t.Errorf("Stat() = size %d, mode %v, want size 5, mode %v", fi.Size(), fi.Mode(), fs.FileMode(0o600))
+	}
+
+	buf, err := fs.ReadFile(fsys, name)
+	if err != nil || string(buf) != "hello" {
+		t.Errorf("ReadFile() = %q, %v, want %q", buf, err, "hello")
+	}
+
+	got, err := io.ReadAll(file)
+	if err != nil || string(got) != "hello" {
+		t.Errorf("Read() = %q, %v, want %q", got, err, "hello")
+	}
+
+	if _, err = fs.ReadFile(fsys, filepath.Join(dir, "missing")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFile() of the missing file = %v, want %v", err, fs.ErrNotExist)
+	}
+
+	for _, cmd := range conn.commands {
+		if cmd != startSFTPServerCmd {
+			t.Errorf("unexpected command %q", cmd)
+		}
+	}
+}
+
+func TestSFTPFallback(t *testing.T) {
+	conn := &fakeConn{}
+
+	for range 2 {
+		if _, ok := New(conn).(*virtfs); !ok {
+			t.Fatal("New() didn't fall back to the shell commands")
+		}
+	}
+
+	if len(conn.commands) != 1 || conn.commands[0] != startSFTPServerCmd {
+		t.Errorf("commands = %q, want only starting the SFTP server once", conn.commands)
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executorfs
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pkg/sftp"
+
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+var (
+	_ executor.MkdirFS      = &sftpfs{}
+	_ executor.ExtendedFile = &sftpfile{}
+	_ io.WriteSeeker        = &sftpfile{}
+)
+
+// sftpfs accesses the files over SFTP, globbing is done by the shell
+type sftpfs struct {
+	client *sftp.Client
+	shell  *virtfs
+}
+
+func (sfs *sftpfs) Open(name string) (fs.File, error) {
+	if !validPath(name) {
+		return nil, &fs.PathError{
+			Op:   "open",
+			Path: name,
+			Err:  fs.ErrInvalid,
+		}
+	}
+
+	return &sftpfile{
+		client: sfs.client,
+		name:   name,
+	}, nil
+}
+
+func (sfs *sftpfs) Glob(pattern string) ([]string, error) {
+	return sfs.shell.Glob(pattern)
+}
+
+// MkdirAll creates the directory with the parents, unlike
+// sftp.Client.MkdirAll setting the permissions of the created directories
+func (sfs *sftpfs) MkdirAll(name string, perm fs.FileMode) error {
+	if fi, err := sfs.client.Stat(name); err == nil {
+		if fi.IsDir() {
+			return nil
+		}
+
+		return sftpPathError("mkdir", name, errors.New("not a directory"))
+	}
+
+	if parent := path.Dir(name); parent != name {
+		if err := sfs.MkdirAll(parent, perm); err != nil {
+			return err
+		}
+	}
+
+	if err := sfs.client.Mkdir(name); err != nil {
+		// created in the meantime
+		if fi, statErr := sfs.client.Stat(name); statErr == nil && fi.IsDir() {
+			return nil
+		}
+
+		return sftpPathError("mkdir", name, err)
+	}
+
+	if err := sfs.client.Chmod(name, perm.Perm()); err != nil {
+		return sftpPathError("mkdir", name, err)
+	}
+
+	return nil
+}
+
+func (sfs *sftpfs) ReadFile(name string) ([]byte, error) {
+	f, err := sfs.client.Open(name)
+	if err != nil {
+		return nil, sftpPathError("read", name, err)
+	}
+	defer f.Close()
+
+	buf, err := io.ReadAll(f)
+	if err != nil {
+		return nil, sftpPathError("read", name, err)
+	}
+
+	return buf, nil
+}
+
+// sftpfile opens the remote file lazily, for reading on the first Read and
+// for writing, without truncating it, on the first Write
+type sftpfile struct {
+	client    *sftp.Client
+	name      string
+	cursor    int64
+	readFile  *sftp.File
+	writeFile *sftp.File
+}
+
+func (sf *sftpfile) Stat() (fs.FileInfo, error) {
+	fi, err := sf.client.Stat(sf.name)
+	if err != nil {
+		return nil, sftpPathError("stat", sf.name, err)
+	}
+
+	return &fileInfo{
+		name: sf.name,
+		size: fi.Size(),
+		mode: fi.Mode(),
+		time: fi.ModTime(),
+	}, nil
+}
+
+func (sf *sftpfile) Read(p []byte) (int, error) {
+	if sf.readFile == nil {
+		f, err := sf.client.Open(sf.name)
+		if err != nil {
+			return 0, &fs.PathError{Op: "read", Path: sf.name, Err: err}
+		}
+		sf.readFile = f
+	}
+
+	n, err := sf.readFile.ReadAt(p, sf.cursor)
+	sf.cursor += int64(n)
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, &fs.PathError{Op: "read", Path: sf.name, Err: err}
+	}
+
+	return n, err
+}
+
+func (sf *sftpfile) Write(p []byte) (int, error) {
+	if sf.writeFile == nil {
+		f, err := sf.client.OpenFile(sf.name, os.O_WRONLY|os.O_CREATE)
+		if err != nil {
+			return 0, &fs.PathError{Op: "write", Path: sf.name, Err: err}
+		}
+		sf.writeFile = f
+	}
+
+	n, err := sf.writeFile.WriteAt(p, sf.cursor)
+	sf.cursor += int64(n)
+
+	if err != nil {
+		return n, &fs.PathError{Op: "write", Path: sf.name, Err: err}
+	}
+
+	return n, nil
+}
+
+func (sf *sftpfile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		sf.cursor = offset
+	case io.SeekCurrent:
+		sf.cursor += offset
+	case io.SeekEnd:
+		fi, err := sf.Stat()
+		if err != nil {
+			return sf.cursor, err
+		}
+		sf.cursor = fi.Size() + offset
+	}
+
+	return sf.cursor, nil
+}
+
+func (sf *sftpfile) Truncate(size int64) error {
+	return sf.pathError("truncate", sf.client.Truncate(sf.name, size))
+}
+
+func (sf *sftpfile) Chown(uid, gid int) error {
+	return sf.pathError("chown", sf.client.Chown(sf.name, uid, gid))
+}
+
+func (sf *sftpfile) Chmod(mode os.FileMode) error {
+	return sf.pathError("chmod", sf.client.Chmod(sf.name, mode.Perm()))
+}
+
+func (sf *sftpfile) pathError(op string, err error) error {
+	if err != nil {
+		return &fs.PathError{Op: op, Path: sf.name, Err: err}
+	}
+
+	return nil
+}
+
+func (sf *sftpfile) Close() error {
+	var err error
+
+	for _, f := range []*sftp.File{sf.readFile, sf.writeFile} {
+		if f == nil {
+			continue
+		}
+
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = &fs.PathError{Op: "close", Path: sf.name, Err: closeErr}
+		}
+	}
+
+	sf.readFile = nil
+	sf.writeFile = nil
+	sf.cursor = 0
+
+	return err
+}
+
+func sftpPathError(op, name string, err error) error {
+	return fail.SSH(&fs.PathError{
+		Op:   op,
+		Path: name,
+		Err:  err,
+	}, "sftp %s", op)
+}
+
+// validPath returns true if the path is valid for fs.FS, optionally with the
+// leading slash
+func validPath(name string) bool {
+	return fs.ValidPath(strings.TrimPrefix(name, "/"))
+}
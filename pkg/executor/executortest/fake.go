@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package executortest implements the executor adapters for testing the
+// tasks without the real machines: the scriptable Fake, and the Recorder and
+// the Replayer of the recorded sessions.
+package executortest
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/executor/executorfs"
+)
+
+var (
+	// ErrUnexpectedCommand is returned for the commands no rule matches
+	ErrUnexpectedCommand = errors.New("unexpected command")
+
+	// ErrNoTunnel is returned when tunneling, which is not supported
+	ErrNoTunnel = errors.New("tunnels are not supported")
+)
+
+var _ executor.Adapter = &Fake{}
+
+// Call is the command run on the host
+type Call struct {
+	// Host is the public, or the private if not set, address of the host
+	Host    string
+	Command string
+	Stdin   string
+}
+
+// Response is the result of the command
+type Response struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	// Err is the error of the connection, e.g. it has been dropped. The
+	// exit error is returned for the non-zero ExitCode if not set.
+	Err error
+}
+
+// ExitError is returned for the commands exiting with the non-zero exit code,
+// executor.IsExitError is true for it
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+func (e *ExitError) ExitStatus() int {
+	return e.Code
+}
+
+// Matcher selects the calls the rule applies to
+type Matcher func(call Call) bool
+
+// Command matches the command exactly
+func Command(cmd string) Matcher {
+	return func(call Call) bool {
+		return call.Command == cmd
+	}
+}
+
+// CommandContains matches the commands containing the substring
+func CommandContains(substr string) Matcher {
+	return func(call Call) bool {
+		return strings.Contains(call.Command, substr)
+	}
+}
+
+// CommandMatches matches the commands matching the regular expression
+func CommandMatches(expr string) Matcher {
+	re := regexp.MustCompile(expr)
+
+	return func(call Call) bool {
+		return re.MatchString(call.Command)
+	}
+}
+
+// Host matches the calls on the host with the given address
+func Host(address string) Matcher {
+	return func(call Call) bool {
+		return call.Host == address
+	}
+}
+
+// All matches the calls all matchers match
+func All(matchers ...Matcher) Matcher {
+	return func(call Call) bool {
+		for _, match := range matchers {
+			if !match(call) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Any matches all calls
+func Any() Matcher {
+	return func(Call) bool {
+		return true
+	}
+}
+
+type rule struct {
+	match   Matcher
+	handler func(call Call) Response
+	// times the rule can still be used, negative for unlimited
+	times int
+}
+
+// Fake is the scriptable executor adapter. The commands are answered by the
+// first matching rule, in the order the rules have been added, and the calls
+// are recorded. The commands no rule matches fail with ErrUnexpectedCommand.
+type Fake struct {
+	// OpenErr, if set, is returned when opening the connection
+	OpenErr error
+
+	lock   sync.Mutex
+	rules  []*rule
+	opened []string
+	calls  []Call
+}
+
+// NewFake returns the fake adapter without any rules
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// Handle answers the matching commands with the response
+func (f *Fake) Handle(match Matcher, resp Response) *Fake {
+	return f.HandleFunc(match, func(Call) Response { return resp })
+}
+
+// HandleOnce answers the first matching command with the response, the
+// following ones are left to the other rules
+func (f *Fake) HandleOnce(match Matcher, resp Response) *Fake {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.rules = append(f.rules, &rule{match: match, handler: func(Call) Response { return resp }, times: 1})
+
+	return f
+}
+
+// HandleFunc answers the matching commands with the response of the handler
+func (f *Fake) HandleFunc(match Matcher, handler func(call Call) Response) *Fake {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.rules = append(f.rules, &rule{match: match, handler: handler, times: -1})
+
+	return f
+}
+
+// Opened returns the addresses of the hosts the connections have been opened
+// to, in order
+func (f *Fake) Opened() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]string(nil), f.opened...)
+}
+
+// Calls returns the commands run, in order
+func (f *Fake) Calls() []Call {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+func (f *Fake) Open(host kubeoneapi.HostConfig) (executor.Interface, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	address := hostAddress(host)
+	f.opened = append(f.opened, address)
+
+	if f.OpenErr != nil {
+		return nil, f.OpenErr
+	}
+
+	return &conn{host: address, run: f.run}, nil
+}
+
+func (f *Fake) Tunnel(kubeoneapi.HostConfig) (executor.Tunneler, error) {
+	return nil, ErrNoTunnel
+}
+
+func (f *Fake) run(call Call) Response {
+	f.lock.Lock()
+	f.calls = append(f.calls, call)
+
+	var handler func(Call) Response
+	for _, r := range f.rules {
+		if r.times != 0 && r.match(call) {
+			if r.times > 0 {
+				r.times--
+			}
+			handler = r.handler
+
+			break
+		}
+	}
+	f.lock.Unlock()
+
+	if handler == nil {
+		return Response{Err: errors.Wrapf(ErrUnexpectedCommand, "%s: %q", call.Host, call.Command)}
+	}
+
+	return handler(call)
+}
+
+// conn runs the commands with the run function, sending the stdin in full
+// before the command is run
+type conn struct {
+	host string
+	run  func(call Call) Response
+}
+
+func (c *conn) Exec(cmd string) (string, string, int, error) {
+	resp := c.do(cmd, nil)
+
+	return resp.Stdout, resp.Stderr, resp.ExitCode, responseError(resp)
+}
+
+func (c *conn) POpen(cmd string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	resp := c.do(cmd, stdin)
+
+	if stdout != nil {
+		_, _ = io.WriteString(stdout, resp.Stdout)
+	}
+	if stderr != nil {
+		_, _ = io.WriteString(stderr, resp.Stderr)
+	}
+
+	return resp.ExitCode, responseError(resp)
+}
+
+func (c *conn) do(cmd string, stdin io.Reader) Response {
+	// the files are accessed with the shell commands, which are easy to match
+	if executorfs.IsSFTPServerCommand(cmd) {
+		return Response{ExitCode: 127, Stderr: "sftp-server: not found"}
+	}
+
+	call := Call{Host: c.host, Command: cmd}
+
+	if stdin != nil {
+		buf, err := io.ReadAll(stdin)
+		if err != nil {
+			return Response{Err: err}
+		}
+		call.Stdin = string(buf)
+	}
+
+	return c.run(call)
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func responseError(resp Response) error {
+	if resp.Err != nil {
+		return resp.Err
+	}
+
+	if resp.ExitCode != 0 {
+		return &ExitError{Code: resp.ExitCode}
+	}
+
+	return nil
+}
+
+func hostAddress(host kubeoneapi.HostConfig) string {
+	if host.PublicAddress != "" {
+		return host.PublicAddress
+	}
+
+	return host.PrivateAddress
+}
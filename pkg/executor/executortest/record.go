@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executortest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/audit"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/executor/executorfs"
+	"k8c.io/kubeone/pkg/fail"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ErrNotRecorded is returned by the Replayer for the commands missing in the
+// fixture
+var ErrNotRecorded = errors.New("command not recorded")
+
+// privateKeyPattern matches the PEM encoded private keys, e.g. of the PKI
+// read and written by the shell commands
+var privateKeyPattern = regexp.MustCompile(`(?s)-----BEGIN ([A-Z0-9 ]*PRIVATE KEY)-----.*?-----END [A-Z0-9 ]*PRIVATE KEY-----`)
+
+var (
+	_ executor.Adapter       = &Recorder{}
+	_ executor.ContextOpener = &Recorder{}
+	_ executor.Adapter       = &Replayer{}
+)
+
+// Fixture is the recorded session, stored as YAML
+type Fixture struct {
+	Commands []RecordedCommand `json:"commands"`
+}
+
+// RecordedCommand is the command run on the host together with its result.
+// The contents of the files written and read by the shell commands are the
+// stdin and the stdout.
+type RecordedCommand struct {
+	Host     string `json:"host"`
+	Command  string `json:"command"`
+	Stdin    string `json:"stdin,omitempty"`
+	Stdout   string `json:"stdout,omitempty"`
+	Stderr   string `json:"stderr,omitempty"`
+	ExitCode int    `json:"exitCode,omitempty"`
+	// Error is the error of the connection, the commands exiting with the
+	// non-zero exit code only have the ExitCode
+	Error string `json:"error,omitempty"`
+}
+
+// LoadFixture reads the fixture from the file
+func LoadFixture(path string) (Fixture, error) {
+	var fixture Fixture
+
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return fixture, fail.Runtime(err, "reading fixture")
+	}
+
+	if err = yaml.UnmarshalStrict(buf, &fixture); err != nil {
+		return fixture, fail.Runtime(err, "unmarshalling fixture")
+	}
+
+	return fixture, nil
+}
+
+// WriteFile writes the fixture to the file
+func (f Fixture) WriteFile(path string) error {
+	buf, err := yaml.Marshal(f)
+	if err != nil {
+		return fail.Runtime(err, "marshalling fixture")
+	}
+
+	return fail.Runtime(os.WriteFile(path, buf, 0o600), "writing fixture")
+}
+
+// Recorder records the commands run over the connections of the inner
+// adapter, e.g. to the real machines, to the fixture. The SFTP server is
+// refused, so the files are accessed with the shell commands and their
+// contents are recorded.
+//
+// The recorded contents include the secrets, e.g. the private keys of the PKI
+// and the bootstrap tokens. The ones Redact recognizes are replaced, but the
+// fixtures must still be reviewed before they're committed.
+type Recorder struct {
+	// Redact replaces the secrets in the commands, their input and output
+	// before they're recorded. NewRecorder sets it to RedactSecrets, nil
+	// records everything as it is.
+	Redact func(s string) string
+
+	inner executor.Adapter
+
+	lock     sync.Mutex
+	commands []RecordedCommand
+}
+
+// NewRecorder returns the recorder of the inner adapter
+func NewRecorder(inner executor.Adapter) *Recorder {
+	return &Recorder{inner: inner, Redact: RedactSecrets}
+}
+
+// RedactSecrets replaces the secrets the audit log redacts, like the tokens
+// and the passwords, and the PEM encoded private keys
+func RedactSecrets(s string) string {
+	return privateKeyPattern.ReplaceAllString(audit.Redact(s), "-----BEGIN ${1}-----\n[REDACTED]\n-----END ${1}-----")
+}
+
+func (r *Recorder) Open(host kubeoneapi.HostConfig) (executor.Interface, error) {
+	inner, err := r.inner.Open(host)
+	if err != nil {
+		return nil, err
+	}
+
+	return recordingConn{inner: inner, host: hostAddress(host), recorder: r}, nil
+}
+
+func (r *Recorder) OpenContext(ctx context.Context, host kubeoneapi.HostConfig) (executor.Interface, error) {
+	inner, err := executor.Open(ctx, r.inner, host)
+	if err != nil {
+		return nil, err
+	}
+
+	return recordingConn{inner: inner, host: hostAddress(host), recorder: r}, nil
+}
+
+func (r *Recorder) Tunnel(host kubeoneapi.HostConfig) (executor.Tunneler, error) {
+	return r.inner.Tunnel(host)
+}
+
+// Fixture returns the commands recorded so far
+func (r *Recorder) Fixture() Fixture {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return Fixture{Commands: append([]RecordedCommand(nil), r.commands...)}
+}
+
+func (r *Recorder) record(cmd RecordedCommand, err error) {
+	if err != nil && !executor.IsExitError(err) {
+		cmd.Error = err.Error()
+	}
+
+	if r.Redact != nil {
+		for _, field := range []*string{&cmd.Command, &cmd.Stdin, &cmd.Stdout, &cmd.Stderr, &cmd.Error} {
+			*field = r.Redact(*field)
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.commands = append(r.commands, cmd)
+}
+
+// recordingConn is the value, so the connections returned for the same
+// cached connection of the inner adapter are equal
+type recordingConn struct {
+	inner    executor.Interface
+	host     string
+	recorder *Recorder
+}
+
+func (c recordingConn) Exec(cmd string) (string, string, int, error) {
+	stdout, stderr, exitCode, err := c.inner.Exec(cmd)
+
+	c.recorder.record(RecordedCommand{
+		Host:     c.host,
+		Command:  cmd,
+		Stdout:   stdout,
+		Stderr:   stderr,
+		ExitCode: exitCode,
+	}, err)
+
+	return stdout, stderr, exitCode, err
+}
+
+func (c recordingConn) POpen(cmd string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	if executorfs.IsSFTPServerCommand(cmd) {
+		return 127, &ExitError{Code: 127}
+	}
+
+	var stdinBuf, stdoutBuf, stderrBuf bytes.Buffer
+
+	if stdin != nil {
+		stdin = io.TeeReader(stdin, &stdinBuf)
+	}
+
+	exitCode, err := c.inner.POpen(cmd, stdin, teeWriter(stdout, &stdoutBuf), teeWriter(stderr, &stderrBuf))
+
+	c.recorder.record(RecordedCommand{
+		Host:     c.host,
+		Command:  cmd,
+		Stdin:    stdinBuf.String(),
+		Stdout:   stdoutBuf.String(),
+		Stderr:   stderrBuf.String(),
+		ExitCode: exitCode,
+	}, err)
+
+	return exitCode, err
+}
+
+func (c recordingConn) Close() error {
+	return c.inner.Close()
+}
+
+// teeWriter copies the output to the buffer, the buffer is only written by
+// the single stream copied by the session
+func teeWriter(w io.Writer, buf *bytes.Buffer) io.Writer {
+	if w == nil {
+		return buf
+	}
+
+	return io.MultiWriter(w, buf)
+}
+
+// Replayer answers the commands with the results recorded in the fixture. The
+// commands of each host are matched in the recorded order, each recorded
+// command is used once. The commands are compared with the secrets redacted
+// by RedactSecrets, so they match the redacted recordings.
+type Replayer struct {
+	// Normalize, if set, is applied to both recorded and run commands before
+	// comparing them, e.g. to replace the generated tokens
+	Normalize func(cmd string) string
+
+	lock     sync.Mutex
+	commands []RecordedCommand
+	used     []bool
+}
+
+// NewReplayer returns the replayer of the fixture
+func NewReplayer(fixture Fixture) *Replayer {
+	return &Replayer{
+		commands: fixture.Commands,
+		used:     make([]bool, len(fixture.Commands)),
+	}
+}
+
+func (r *Replayer) Open(host kubeoneapi.HostConfig) (executor.Interface, error) {
+	return &conn{host: hostAddress(host), run: r.run}, nil
+}
+
+func (r *Replayer) Tunnel(kubeoneapi.HostConfig) (executor.Tunneler, error) {
+	return nil, ErrNoTunnel
+}
+
+// Unused returns the recorded commands that haven't been run
+func (r *Replayer) Unused() []RecordedCommand {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var unused []RecordedCommand
+	for idx, cmd := range r.commands {
+		if !r.used[idx] {
+			unused = append(unused, cmd)
+		}
+	}
+
+	return unused
+}
+
+func (r *Replayer) normalize(cmd string) string {
+	if r.Normalize == nil {
+		return cmd
+	}
+
+	return r.Normalize(cmd)
+}
+
+func (r *Replayer) run(call Call) Response {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	want := r.normalize(RedactSecrets(call.Command))
+
+	for idx, recorded := range r.commands {
+		if r.used[idx] || recorded.Host != call.Host || r.normalize(RedactSecrets(recorded.Command)) != want {
+			continue
+		}
+
+		r.used[idx] = true
+
+		resp := Response{
+			Stdout:   recorded.Stdout,
+			Stderr:   recorded.Stderr,
+			ExitCode: recorded.ExitCode,
+		}
+		if recorded.Error != "" {
+			resp.Err = errors.New(recorded.Error)
+		}
+
+		return resp
+	}
+
+	return Response{Err: errors.Wrapf(ErrNotRecorded, "%s: %q", call.Host, strings.TrimSpace(call.Command))}
+}
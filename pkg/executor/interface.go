@@ -70,3 +70,19 @@ type Adapter interface {
 	Open(host kubeoneapi.HostConfig) (Interface, error)
 	Tunnel(host kubeoneapi.HostConfig) (Tunneler, error)
 }
+
+// ContextOpener is implemented by the adapters that accept the context, e.g.
+// to trace opening the connection as part of the node span
+type ContextOpener interface {
+	OpenContext(ctx context.Context, host kubeoneapi.HostConfig) (Interface, error)
+}
+
+// Open opens the connection to the host with the context if the adapter
+// supports it
+func Open(ctx context.Context, adapter Adapter, host kubeoneapi.HostConfig) (Interface, error) {
+	if opener, ok := adapter.(ContextOpener); ok && ctx != nil {
+		return opener.OpenContext(ctx, host)
+	}
+
+	return adapter.Open(host)
+}
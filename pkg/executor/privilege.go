@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+// escalationTpl wraps the command, so the sudo calls anywhere in it, including
+// the nested shells, xargs and the other programs running sudo, run the
+// command with the configured privilege escalation instead. The sudo
+// executable is shadowed by the script in the temporary directory put first in
+// PATH. The script drops the sudo options, and runs the command with env, so
+// the leading VAR=value assignments, which sudo accepts, keep working. doas
+// and sudo reset the environment, so the escalated command gets PATH and
+// KUBEONE_ESCALATED again, and the nested sudo calls of the already escalated
+// command run their commands directly. The directory is created in the home
+// directory, as /tmp is often mounted noexec.
+const escalationTpl = `KUBEONE_SUDO_DIR=$(mktemp -d "$HOME/.kubeone-sudo.XXXXXX") || exit 1
+trap 'rm -rf "$KUBEONE_SUDO_DIR"' EXIT
+cat > "$KUBEONE_SUDO_DIR/sudo" <<'KUBEONE_SUDO'
+#!/bin/sh
+while [ $# -gt 0 ]; do
+	case "$1" in
+	-u|-g|-C|-D|-h|-p|-r|-t|-T|-U) shift 2 ;;
+	--) shift; break ;;
+	-*) shift ;;
+	*) break ;;
+	esac
+done
+if [ -n "${KUBEONE_ESCALATED:-}" ]; then
+	exec env "$@"
+fi
+dir=$(dirname "$0")
+PATH=${PATH#"$dir:"}
+exec %s env PATH="$dir:$PATH" KUBEONE_ESCALATED=1 "$@"
+KUBEONE_SUDO
+chmod +x "$KUBEONE_SUDO_DIR/sudo"
+export PATH="$KUBEONE_SUDO_DIR:$PATH"
+`
+
+var (
+	_ Adapter       = &escalatingAdapter{}
+	_ ContextOpener = &escalatingAdapter{}
+)
+
+// WithPrivilegeEscalation returns the adapter running the commands on each
+// host with the privilege escalation configured for the host. The scripts are
+// written for sudo, which is kept as is, the other methods wrap every command
+// to replace sudo.
+func WithPrivilegeEscalation(adapter Adapter) Adapter {
+	return &escalatingAdapter{inner: adapter}
+}
+
+type escalatingAdapter struct {
+	inner Adapter
+}
+
+func (a *escalatingAdapter) Open(host kubeoneapi.HostConfig) (Interface, error) {
+	conn, err := a.inner.Open(host)
+	if err != nil {
+		return nil, err
+	}
+
+	return escalate(conn, host.PrivilegeEscalation), nil
+}
+
+func (a *escalatingAdapter) OpenContext(ctx context.Context, host kubeoneapi.HostConfig) (Interface, error) {
+	conn, err := Open(ctx, a.inner, host)
+	if err != nil {
+		return nil, err
+	}
+
+	return escalate(conn, host.PrivilegeEscalation), nil
+}
+
+// Tunnel doesn't run any commands on the host
+func (a *escalatingAdapter) Tunnel(host kubeoneapi.HostConfig) (Tunneler, error) {
+	return a.inner.Tunnel(host)
+}
+
+func escalate(conn Interface, pe kubeoneapi.PrivilegeEscalation) Interface {
+	prelude := escalationPrelude(pe)
+	if prelude == "" {
+		return conn
+	}
+
+	return escalatingConn{inner: conn, prelude: prelude}
+}
+
+// escalationPrelude returns the shell code replacing sudo with the privilege
+// escalation method for the rest of the command, empty for sudo itself
+func escalationPrelude(pe kubeoneapi.PrivilegeEscalation) string {
+	var wrapper string
+
+	switch pe.Method {
+	case "", kubeoneapi.PrivilegeEscalationSudo:
+		return ""
+	case kubeoneapi.PrivilegeEscalationNone:
+		wrapper = ""
+	case kubeoneapi.PrivilegeEscalationDoas:
+		wrapper = "doas"
+	case kubeoneapi.PrivilegeEscalationCustom:
+		wrapper = pe.Command
+	}
+
+	return fmt.Sprintf(escalationTpl, wrapper)
+}
+
+// escalatingConn is the value, so the connections returned for the same
+// cached connection of the inner adapter are equal, which keeps the
+// per-connection state, e.g. the SFTP session of executorfs, shared between
+// them
+type escalatingConn struct {
+	inner   Interface
+	prelude string
+}
+
+func (c escalatingConn) Exec(cmd string) (string, string, int, error) {
+	return c.inner.Exec(c.prelude + cmd)
+}
+
+func (c escalatingConn) POpen(cmd string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	return c.inner.POpen(c.prelude+cmd, stdin, stdout, stderr)
+}
+
+func (c escalatingConn) Close() error {
+	return c.inner.Close()
+}
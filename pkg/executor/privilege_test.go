@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+func TestWithPrivilegeEscalation(t *testing.T) {
+	tests := []struct {
+		name string
+		pe   kubeoneapi.PrivilegeEscalation
+		cmd  string
+		want string
+	}{
+		{
+			name: "none",
+			pe:   kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationNone},
+			cmd:  `sudo -n DEBIAN_FRONTEND=noninteractive sh -c 'echo $DEBIAN_FRONTEND'`,
+			want: "noninteractive",
+		},
+		{
+			name: "none through xargs",
+			pe:   kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationNone},
+			cmd:  `echo escalated | xargs sudo echo`,
+			want: "escalated",
+		},
+		{
+			name: "custom",
+			pe:   kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationCustom, Command: "env ESCALATED=yes"},
+			cmd:  `sudo sh -c 'echo $ESCALATED'`,
+			want: "yes",
+		},
+		{
+			name: "custom in nested script",
+			pe:   kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationCustom, Command: "env ESCALATED=yes"},
+			cmd:  `bash -c "sudo sh -c 'echo \$ESCALATED'"`,
+			want: "yes",
+		},
+	}
+
+	adapter := WithPrivilegeEscalation(NewLocal(context.Background()))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := Open(context.Background(), adapter, kubeoneapi.HostConfig{PrivilegeEscalation: tt.pe})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			stdout, stderr, _, err := conn.Exec(tt.cmd)
+			if err != nil {
+				t.Fatalf("Exec() = %v, stderr: %s", err, stderr)
+			}
+
+			if got := strings.TrimSpace(stdout); got != tt.want {
+				t.Errorf("Exec() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithPrivilegeEscalationSudo(t *testing.T) {
+	inner := NewLocal(context.Background())
+
+	conn, err := WithPrivilegeEscalation(inner).Open(kubeoneapi.HostConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, ok := conn.(escalatingConn); ok {
+		t.Error("sudo commands are rewritten, want them run as is")
+	}
+}
+
+func TestWithPrivilegeEscalationNested(t *testing.T) {
+	// doas resets the environment, like the real one
+	bin := t.TempDir()
+	doas := "#!/bin/sh\nexec env -i PATH=/usr/bin:/bin DOAS=yes \"$@\"\n"
+	if err := os.WriteFile(filepath.Join(bin, "doas"), []byte(doas), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+":"+os.Getenv("PATH"))
+
+	tests := []struct {
+		name     string
+		pe       kubeoneapi.PrivilegeEscalation
+		wantDoas string
+	}{
+		{
+			name:     "doas",
+			pe:       kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationDoas},
+			wantDoas: "yes",
+		},
+		{
+			name:     "none",
+			pe:       kubeoneapi.PrivilegeEscalation{Method: kubeoneapi.PrivilegeEscalationNone},
+			wantDoas: "no",
+		},
+	}
+
+	adapter := WithPrivilegeEscalation(NewLocal(context.Background()))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+
+			conn, err := Open(context.Background(), adapter, kubeoneapi.HostConfig{PrivilegeEscalation: tt.pe})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			stdout, stderr, _, err := conn.Exec(`sudo bash -c 'echo "${DOAS:-no}"; sudo sh -c "command -v sudo"'`)
+			if err != nil {
+				t.Fatalf("Exec() = %v, stderr: %s", err, stderr)
+			}
+
+			lines := strings.Split(strings.TrimSpace(stdout), "\n")
+			if len(lines) != 2 {
+				t.Fatalf("Exec() = %q, want 2 lines", stdout)
+			}
+			if lines[0] != tt.wantDoas {
+				t.Errorf("run with doas = %q, want %q", lines[0], tt.wantDoas)
+			}
+			if !strings.HasPrefix(lines[1], filepath.Join(home, ".kubeone-sudo.")) {
+				t.Errorf("nested sudo = %q, want the escalating sudo", lines[1])
+			}
+
+			if left, _ := os.ReadDir(home); len(left) > 0 {
+				t.Errorf("escalating sudo is left in %s", home)
+			}
+		})
+	}
+}
@@ -138,6 +138,7 @@ type ConfigError struct {
 func (e ConfigError) Error() string { return fmt.Sprintf("configuration %s\n%s", e.Op, e.Err) }
 func (e ConfigError) Unwrap() error { return e.Err }
 func (e ConfigError) exitCode() int { return ConfigErrorExitCode }
+func (e ConfigError) nonRetryable() {}
 
 // CredentialsError wraps cloud provider credentials related errors
 type CredentialsError struct {
@@ -165,6 +166,7 @@ func (e CredentialsError) Error() string {
 
 func (e CredentialsError) Unwrap() error { return e.Err }
 func (e CredentialsError) exitCode() int { return ConfigErrorExitCode }
+func (e CredentialsError) nonRetryable() {}
 
 // MachineControllerError wraps machine creation related errors
 type MachineControllerError struct {
@@ -209,3 +211,30 @@ func (e CloudError) Error() string {
 
 func (e CloudError) Unwrap() error { return e.Err }
 func (e CloudError) exitCode() int { return CloudErrorExitCode }
+
+// InterruptedError is returned when the run was interrupted by the user
+type InterruptedError struct {
+	Err error
+	Op  string
+}
+
+func (e InterruptedError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("interrupted at step %q\n%s", e.Op, e.Err)
+	}
+
+	return fmt.Sprintf("interrupted at step %q", e.Op)
+}
+
+func (e InterruptedError) Unwrap() error { return e.Err }
+func (e InterruptedError) exitCode() int { return InterruptedErrorExitCode }
+func (e InterruptedError) nonRetryable() {}
+
+// NonRetryableError marks the error as one that retrying won't fix
+type NonRetryableError struct {
+	Err error
+}
+
+func (e NonRetryableError) Error() string { return e.Err.Error() }
+func (e NonRetryableError) Unwrap() error { return e.Err }
+func (e NonRetryableError) nonRetryable() {}
@@ -16,7 +16,10 @@ limitations under the License.
 
 package fail
 
-import "errors"
+import (
+	"errors"
+	"reflect"
+)
 
 const (
 	DefaultExitCode                = 1
@@ -29,6 +32,8 @@ const (
 	ExecErrorExitCode              = 16
 	MachineControllerErrorExitCode = 17
 	CloudErrorExitCode             = 18
+	// InterruptedErrorExitCode is the same exit code shells use for SIGINT
+	InterruptedErrorExitCode = 130
 )
 
 type exitCoder interface {
@@ -46,6 +51,7 @@ var (
 	_ exitCoder = CredentialsError{}
 	_ exitCoder = MachineControllerError{}
 	_ exitCoder = CloudError{}
+	_ exitCoder = InterruptedError{}
 )
 
 func ExitCode(err error) int {
@@ -59,3 +65,13 @@ func ExitCode(err error) int {
 
 	return DefaultExitCode
 }
+
+// ErrorType returns the name of the error type ExitCode is based on, e.g.
+// SSHError, or an empty string for the errors without the exit code.
+func ErrorType(err error) string {
+	if exiter, ok := errors.AsType[exitCoder](err); ok {
+		return reflect.TypeOf(exiter).Name()
+	}
+
+	return ""
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fail
+
+import (
+	"errors"
+)
+
+type nonRetryableError interface {
+	error
+	nonRetryable()
+}
+
+// aggregateError is implemented by k8s.io/apimachinery/pkg/util/errors.Aggregate
+type aggregateError interface {
+	error
+	Errors() []error
+}
+
+var (
+	_ nonRetryableError = ConfigError{}
+	_ nonRetryableError = CredentialsError{}
+	_ nonRetryableError = InterruptedError{}
+	_ nonRetryableError = NonRetryableError{}
+)
+
+// NonRetryable is a shortcut to quickly construct NonRetryableError
+func NonRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return NonRetryableError{Err: err}
+}
+
+// Retryable returns false if the error, or any error it wraps, is one that
+// retrying won't fix, e.g. ConfigError, CredentialsError or the error marked
+// with NonRetryable. The aggregated errors, e.g. from running the task on
+// multiple nodes, are not retryable if any of them is not.
+func Retryable(err error) bool {
+	if _, ok := errors.AsType[nonRetryableError](err); ok {
+		return false
+	}
+
+	if agg, ok := errors.AsType[aggregateError](err); ok {
+		for _, aggErr := range agg.Errors() {
+			if !Retryable(aggErr) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fail
+
+import (
+	"errors"
+	"testing"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+func TestRetryable(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "plain error",
+			err:  errFailed,
+			want: true,
+		},
+		{
+			name: "ssh error",
+			err:  SSH(errFailed, "running command"),
+			want: true,
+		},
+		{
+			name: "config error",
+			err:  ConfigValidation(errFailed),
+			want: false,
+		},
+		{
+			name: "credentials error wrapped in runtime error",
+			err:  Runtime(CredentialsError{Err: errFailed}, "ensuring credentials"),
+			want: false,
+		},
+		{
+			name: "marked as non-retryable",
+			err:  Runtime(NonRetryable(errFailed), "upgrading node"),
+			want: false,
+		},
+		{
+			name: "aggregate of retryable errors",
+			err:  utilerrors.NewAggregate([]error{errFailed, SSH(errFailed, "running command")}),
+			want: true,
+		},
+		{
+			name: "aggregate with non-retryable error",
+			err:  utilerrors.NewAggregate([]error{errFailed, Runtime(NonRetryable(errFailed), "upgrading node")}),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Retryable(tt.err); got != tt.want {
+				t.Errorf("Retryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package runner
 
 import (
+	"context"
 	"os"
 
 	"github.com/koron-go/prefixw"
@@ -27,11 +28,14 @@ import (
 	"k8c.io/kubeone/pkg/executor/executorfs"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/tracing"
 )
 
 // Runner bundles a connection to a host with the verbosity and
 // other options for running commands via SSH.
 type Runner struct {
+	// Context carries the tracing span of the node the commands are run on
+	Context  context.Context
 	Executor executor.Interface
 	Prefix   string
 	OS       kubeoneapi.OperatingSystemName
@@ -45,7 +49,16 @@ func (r *Runner) NewFS() executor.MkdirFS {
 	return executorfs.New(r.Executor)
 }
 
-func (r *Runner) RunRaw(cmd string) (string, string, error) {
+// RunRaw runs the command. The command is not recorded in the tracing span
+// because the scripts often embed the secrets, e.g. the join tokens.
+func (r *Runner) RunRaw(cmd string) (stdout, stderr string, err error) {
+	_, span := tracing.Start(r.Context, "command")
+	defer func() { tracing.End(span, err) }()
+
+	return r.runRaw(cmd)
+}
+
+func (r *Runner) runRaw(cmd string) (string, string, error) {
 	if r.Executor == nil {
 		return "", "", fail.RuntimeError{
 			Op:  "checking available executor adapter",
@@ -55,10 +68,7 @@ func (r *Runner) RunRaw(cmd string) (string, string, error) {
 
 	if !r.Verbose {
 		stdout, stderr, _, err := r.Executor.Exec(cmd)
-		if err != nil {
-			r.Executor.Close()
-			r.Executor = nil
-		}
+		r.closeOnTransportError(err)
 
 		return stdout, stderr, err
 	}
@@ -71,14 +81,23 @@ func (r *Runner) RunRaw(cmd string) (string, string, error) {
 
 	// run the command
 	_, err := r.Executor.POpen(cmd, nil, stdout, stderr)
-	if err != nil {
-		r.Executor.Close()
-		r.Executor = nil
-	}
+	r.closeOnTransportError(err)
 
 	return stdout.String(), stderr.String(), err
 }
 
+// closeOnTransportError closes the executor if the command has failed because
+// of the connection, e.g. it has been dropped. The executor is kept open if
+// the command has only exited with the non-zero exit code.
+func (r *Runner) closeOnTransportError(err error) {
+	if err == nil || executor.IsExitError(err) {
+		return
+	}
+
+	r.Executor.Close()
+	r.Executor = nil
+}
+
 // Run executes a given command/script, optionally printing its output to
 // stdout/stderr.
 func (r *Runner) Run(cmd string, variables TemplateVariables) (string, string, error) {
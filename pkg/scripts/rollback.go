@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// RollbackDir is the directory on the node holding the files backed up
+// before the upgrade
+const RollbackDir = "/etc/kubernetes/kubeone-rollback"
+
+// RollbackEtcdSnapshot is the path of the etcd snapshot uploaded to the
+// control plane node to restore etcd from
+const RollbackEtcdSnapshot = RollbackDir + "/etcd-snapshot.db"
+
+var (
+	rollbackBackupScriptTemplate = heredoc.Doc(`
+		sudo rm -rf {{ .DIR }}
+		sudo mkdir -p {{ .DIR }}
+		if [[ -d /etc/kubernetes/manifests ]]; then
+			sudo cp -a /etc/kubernetes/manifests {{ .DIR }}/manifests
+		fi
+		for file in config.yaml kubeadm-flags.env; do
+			if [[ -f /var/lib/kubelet/$file ]]; then
+				sudo cp -a /var/lib/kubelet/$file {{ .DIR }}/$file
+			fi
+		done
+	`)
+
+	rollbackRestoreScriptTemplate = heredoc.Doc(`
+		if [[ ! -d {{ .DIR }} ]]; then
+			echo "no pre-upgrade backup found in {{ .DIR }}" >&2
+			exit 1
+		fi
+
+		{{- if .CONTROL_PLANE }}
+
+		for manifest in {{ .DIR }}/manifests/*.yaml; do
+			{{- if not .RESTORE_ETCD }}
+			[[ "$(basename $manifest)" == etcd.yaml ]] && continue
+			{{- end }}
+			sudo cp -a $manifest /etc/kubernetes/manifests/
+		done
+		{{- end }}
+
+		for file in config.yaml kubeadm-flags.env; do
+			if [[ -f {{ .DIR }}/$file ]]; then
+				sudo cp -a {{ .DIR }}/$file /var/lib/kubelet/$file
+			fi
+		done
+
+		sudo systemctl daemon-reload
+		sudo systemctl restart kubelet
+	`)
+
+	rollbackStopControlPlaneScriptTemplate = heredoc.Doc(`
+		sudo mkdir -p {{ .DIR }}/stopped
+		for manifest in /etc/kubernetes/manifests/*.yaml; do
+			if [[ -f $manifest ]]; then
+				sudo mv $manifest {{ .DIR }}/stopped/
+			fi
+		done
+
+		for i in $(seq 1 60); do
+			[[ -z "$(sudo crictl ps --name '^etcd$' -q)" ]] && break
+			sleep 2
+		done
+		if [[ -n "$(sudo crictl ps --name '^etcd$' -q)" ]]; then
+			echo "etcd didn't stop" >&2
+			exit 1
+		fi
+	`)
+
+	rollbackRestoreEtcdScriptTemplate = heredoc.Doc(`
+		etcd_image=$(sudo grep -m1 'image:' {{ .DIR }}/manifests/etcd.yaml | awk '{print $2}')
+		sudo crictl pull "$etcd_image"
+
+		if [[ -d /var/lib/etcd ]]; then
+			sudo mv /var/lib/etcd /var/lib/etcd.before-rollback-$(date +%s)
+		fi
+
+		sudo ctr -n k8s.io run --rm --net-host \
+			--mount type=bind,src=/var/lib,dst=/var/lib,options=rbind:rw \
+			--mount type=bind,src={{ .DIR }},dst={{ .DIR }},options=rbind:ro \
+			"$etcd_image" kubeone-etcd-restore \
+			etcdutl snapshot restore {{ .SNAPSHOT }} \
+				--data-dir /var/lib/etcd \
+				--name {{ .NAME }} \
+				--initial-cluster {{ .INITIAL_CLUSTER }} \
+				--initial-advertise-peer-urls {{ .PEER_URL }}
+
+		sudo cp -a {{ .DIR }}/manifests/*.yaml /etc/kubernetes/manifests/
+		sudo rm -rf {{ .DIR }}/stopped
+	`)
+)
+
+// RollbackBackup backs up the static pod manifests and the kubelet
+// configuration of the node before the upgrade
+func RollbackBackup() (string, error) {
+	result, err := Render(rollbackBackupScriptTemplate, Data{
+		"DIR": RollbackDir,
+	})
+
+	return result, fail.Runtime(err, "rendering rollbackBackupScriptTemplate script")
+}
+
+// RollbackRestore restores the static pod manifests of the control plane
+// node, except for etcd unless restoreEtcd is set, and the kubelet
+// configuration backed up before the upgrade
+func RollbackRestore(controlPlane, restoreEtcd bool) (string, error) {
+	result, err := Render(rollbackRestoreScriptTemplate, Data{
+		"DIR":           RollbackDir,
+		"CONTROL_PLANE": controlPlane,
+		"RESTORE_ETCD":  restoreEtcd,
+	})
+
+	return result, fail.Runtime(err, "rendering rollbackRestoreScriptTemplate script")
+}
+
+// RollbackStopControlPlane stops the static pods of the control plane node
+// and waits for etcd to stop
+func RollbackStopControlPlane() (string, error) {
+	result, err := Render(rollbackStopControlPlaneScriptTemplate, Data{
+		"DIR": RollbackDir,
+	})
+
+	return result, fail.Runtime(err, "rendering rollbackStopControlPlaneScriptTemplate script")
+}
+
+// RollbackRestoreEtcd restores the etcd member data from the uploaded
+// snapshot, using etcdutl of the etcd image which was running before the
+// upgrade, and starts the static pods backed up before the upgrade
+func RollbackRestoreEtcd(name, peerURL, initialCluster string) (string, error) {
+	result, err := Render(rollbackRestoreEtcdScriptTemplate, Data{
+		"DIR":             RollbackDir,
+		"SNAPSHOT":        RollbackEtcdSnapshot,
+		"NAME":            name,
+		"PEER_URL":        peerURL,
+		"INITIAL_CLUSTER": initialCluster,
+	})
+
+	return result, fail.Runtime(err, "rendering rollbackRestoreEtcdScriptTemplate script")
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"errors"
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestRollbackRestore(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		controlPlane bool
+		restoreEtcd  bool
+	}
+	tests := []struct {
+		name string
+		args args
+		err  error
+	}{
+		{
+			name: "control-plane",
+			args: args{
+				controlPlane: true,
+			},
+		},
+		{
+			name: "control-plane-with-etcd",
+			args: args{
+				controlPlane: true,
+				restoreEtcd:  true,
+			},
+		},
+		{
+			name: "static-worker",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := RollbackRestore(tt.args.controlPlane, tt.args.restoreEtcd)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("RollbackRestore() error = %v, wantErr %v", err, tt.err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
+
+func TestRollbackRestoreEtcd(t *testing.T) {
+	t.Parallel()
+
+	got, err := RollbackRestoreEtcd("cp-0", "https://10.0.0.1:2380", "cp-0=https://10.0.0.1:2380,cp-1=https://10.0.0.2:2380")
+	if err != nil {
+		t.Fatalf("RollbackRestoreEtcd() error = %v", err)
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -36,7 +37,17 @@ import (
 	"k8c.io/kubeone/pkg/fail"
 )
 
-const socketEnvPrefix = "env:"
+const (
+	socketEnvPrefix = "env:"
+
+	// defaultKeepAliveInterval and defaultKeepAliveCountMax are the defaults
+	// of the keepalive requests, like ServerAliveInterval and
+	// ServerAliveCountMax of OpenSSH
+	defaultKeepAliveInterval = 15 * time.Second
+	defaultKeepAliveCountMax = 3
+
+	keepAliveRequest = "keepalive@openssh.com"
+)
 
 var _ executor.Tunneler = &connection{}
 
@@ -61,6 +72,37 @@ type Opts struct {
 	BastionHostPublicKey  []byte
 	BastionPrivateKeyFile string
 	BastionPrivateKey     []byte
+	// JumpHosts are dialed in order to reach the host. The legacy Bastion
+	// options are converted to the single jump host.
+	JumpHosts []JumpHostOpts
+	// KeepAliveInterval is the interval of the keepalive requests, which keep
+	// the idle connection open and detect the dead one. Negative value
+	// disables the keepalive requests.
+	KeepAliveInterval time.Duration
+	// KeepAliveCountMax is the number of the unanswered keepalive requests
+	// after which the connection is closed
+	KeepAliveCountMax int
+
+	// hostKeys verifies the host keys, only the keys set in the options are
+	// verified if nil
+	hostKeys hostKeyChecker
+	// privateKeyName and bastionPrivateKeyName are the files the keys have
+	// been read from, used when asking for the passphrase
+	privateKeyName        string
+	bastionPrivateKeyName string
+}
+
+// JumpHostOpts represents the options for connecting to the jump host. The
+// Username and the auth methods of the node are used if not set.
+type JumpHostOpts struct {
+	Hostname       string
+	Port           int
+	Username       string
+	HostPublicKey  []byte
+	PrivateKeyFile string
+	PrivateKey     []byte
+
+	privateKeyName string
 }
 
 func validateOptions(o Opts) (Opts, error) {
@@ -83,6 +125,7 @@ func validateOptions(o Opts) (Opts, error) {
 		}
 
 		o.PrivateKey = content
+		o.privateKeyName = o.KeyFile
 		o.KeyFile = ""
 	}
 
@@ -93,6 +136,7 @@ func validateOptions(o Opts) (Opts, error) {
 		}
 
 		o.BastionPrivateKey = content
+		o.bastionPrivateKeyName = o.BastionPrivateKeyFile
 		o.BastionPrivateKeyFile = ""
 	}
 
@@ -118,10 +162,61 @@ func validateOptions(o Opts) (Opts, error) {
 		o.BastionUser = o.Username
 	}
 
+	if o.Bastion != "" && len(o.JumpHosts) == 0 {
+		o.JumpHosts = []JumpHostOpts{
+			{
+				Hostname:      o.Bastion,
+				Port:          o.BastionPort,
+				Username:      o.BastionUser,
+				HostPublicKey: o.BastionHostPublicKey,
+				PrivateKey:    o.BastionPrivateKey,
+
+				privateKeyName: o.bastionPrivateKeyName,
+			},
+		}
+	}
+
+	jumpHosts := make([]JumpHostOpts, 0, len(o.JumpHosts))
+	for _, jumpHost := range o.JumpHosts {
+		if len(jumpHost.Hostname) == 0 {
+			return o, fail.ConfigValidation(errors.New("no hostname specified for SSH jump host"))
+		}
+
+		if len(jumpHost.PrivateKeyFile) > 0 {
+			content, err := os.ReadFile(jumpHost.PrivateKeyFile)
+			if err != nil {
+				return o, fail.Config(err, "reading jump host SSH private key")
+			}
+
+			jumpHost.PrivateKey = content
+			jumpHost.privateKeyName = jumpHost.PrivateKeyFile
+			jumpHost.PrivateKeyFile = ""
+		}
+
+		if jumpHost.Port <= 0 {
+			jumpHost.Port = 22
+		}
+
+		if jumpHost.Username == "" {
+			jumpHost.Username = o.Username
+		}
+
+		jumpHosts = append(jumpHosts, jumpHost)
+	}
+	o.JumpHosts = jumpHosts
+
 	if o.Timeout == 0 {
 		o.Timeout = 60 * time.Second
 	}
 
+	if o.KeepAliveInterval == 0 {
+		o.KeepAliveInterval = defaultKeepAliveInterval
+	}
+
+	if o.KeepAliveCountMax <= 0 {
+		o.KeepAliveCountMax = defaultKeepAliveCountMax
+	}
+
 	return o, nil
 }
 
@@ -131,6 +226,11 @@ type connection struct {
 	connector *Connector
 	ctx       context.Context
 	cancel    context.CancelFunc
+	// jumpClients are the connections to the jump hosts, in dial order
+	jumpClients []*ssh.Client
+	// closed is set once the connection is closed, either explicitly or
+	// because it has been found dead
+	closed atomic.Bool
 }
 
 // NewConnection attempts to create a new SSH connection to the host
@@ -149,11 +249,11 @@ func NewConnection(connector *Connector, opts Opts) (executor.Interface, error)
 	}
 
 	if len(opts.PrivateKey) > 0 {
-		signer, parseErr := ssh.ParsePrivateKey(opts.PrivateKey)
+		signer, parseErr := parsePrivateKey(opts.PrivateKey, opts.privateKeyName, connector.passphrases)
 		if parseErr != nil {
 			return nil, fail.SSHError{
 				Op:  "parsing private key",
-				Err: errors.Wrap(parseErr, "SSH key could not be parsed (note that password-protected keys are not supported)"),
+				Err: errors.Wrap(parseErr, "SSH key could not be parsed"),
 			}
 		}
 
@@ -188,23 +288,6 @@ func NewConnection(connector *Connector, opts Opts) (executor.Interface, error)
 		}
 	}
 
-	// bastionAuthMethods are used exclusively for the bastion hop. When a
-	// dedicated bastion key is provided we use only that key so we never waste
-	// MaxAuthTries attempts on the node key (which the bastion does not know).
-	// If no bastion key is configured we fall back to the node auth methods.
-	bastionAuthMethods := nodeAuthMethods
-
-	if len(opts.BastionPrivateKey) > 0 {
-		signer, parseErr := ssh.ParsePrivateKey(opts.BastionPrivateKey)
-		if parseErr != nil {
-			return nil, fail.SSHError{
-				Op:  "parsing private key",
-				Err: errors.Wrap(parseErr, "bastion SSH key could not be parsed (note that password-protected keys are not supported)"),
-			}
-		}
-		bastionAuthMethods = []ssh.AuthMethod{ssh.PublicKeys(signer)}
-	}
-
 	if len(opts.AgentSocket) > 0 {
 		addr := opts.AgentSocket
 
@@ -243,83 +326,158 @@ func NewConnection(connector *Connector, opts Opts) (executor.Interface, error)
 			socket.Close()
 		default:
 			nodeAuthMethods = append(nodeAuthMethods, ssh.PublicKeys(signers...))
-			// only add agent keys to bastion if no dedicated bastion key was set
-			if len(opts.BastionPrivateKey) == 0 {
-				bastionAuthMethods = nodeAuthMethods
-			}
 		}
 	}
 
+	hostKeys := opts.hostKeys
+	if hostKeys == nil {
+		hostKeys = pinnedHostKeys{}
+	}
+
 	nodeConfig := &ssh.ClientConfig{
-		User:            opts.Username,
-		Timeout:         opts.Timeout,
-		Auth:            nodeAuthMethods,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec
+		User:    opts.Username,
+		Timeout: opts.Timeout,
+		Auth:    nodeAuthMethods,
 	}
 
-	if opts.HostPublicKey != nil {
-		nodeConfig.HostKeyCallback = hostKeyCallback(opts.HostPublicKey)
+	var (
+		jumpClients []*ssh.Client
+		client      *ssh.Client
+	)
+
+	closeJumpClients := func() {
+		for i := len(jumpClients) - 1; i >= 0; i-- {
+			jumpClients[i].Close()
+		}
 	}
 
-	// No bastion: connect directly to the node.
-	if opts.Bastion == "" {
-		endpoint := net.JoinHostPort(opts.Hostname, strconv.Itoa(opts.Port))
+	// Dial the jump hosts one by one, each through the previous one, and
+	// finally the node itself.
+	for _, jumpHost := range opts.JumpHosts {
+		jumpConfig, configErr := jumpHostConfig(jumpHost, opts.Timeout, nodeAuthMethods, connector.passphrases)
+		if configErr != nil {
+			closeJumpClients()
 
-		client, dialErr := ssh.Dial("tcp", endpoint, nodeConfig)
-		if dialErr != nil {
-			return nil, fail.SSH(fail.Connection(dialErr, endpoint), "dialing")
+			return nil, configErr
 		}
 
-		ctx, cancelFn := context.WithCancel(connector.ctx)
+		endpoint := net.JoinHostPort(jumpHost.Hostname, strconv.Itoa(jumpHost.Port))
+		hostKeys.configure(jumpConfig, endpoint, jumpHost.HostPublicKey)
+
+		client, err = dialHop(connector.ctx, client, endpoint, jumpConfig)
+		if err != nil {
+			closeJumpClients()
+
+			return nil, err
+		}
 
-		return &connection{
-			sshclient: client,
-			connector: connector,
-			ctx:       ctx,
-			cancel:    cancelFn,
-		}, nil
+		jumpClients = append(jumpClients, client)
 	}
 
-	// Connect to the bastion with its own dedicated auth methods.
-	bastionConfig := &ssh.ClientConfig{
-		User:            opts.BastionUser,
-		Timeout:         opts.Timeout,
-		Auth:            bastionAuthMethods,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec
+	endpoint := net.JoinHostPort(opts.Hostname, strconv.Itoa(opts.Port))
+	hostKeys.configure(nodeConfig, endpoint, opts.HostPublicKey)
+
+	client, err = dialHop(connector.ctx, client, endpoint, nodeConfig)
+	if err != nil {
+		closeJumpClients()
+
+		return nil, err
 	}
 
-	if opts.BastionHostPublicKey != nil {
-		bastionConfig.HostKeyCallback = hostKeyCallback(opts.BastionHostPublicKey)
+	ctx, cancelFn := context.WithCancel(connector.ctx)
+
+	conn := &connection{
+		sshclient:   client,
+		connector:   connector,
+		ctx:         ctx,
+		cancel:      cancelFn,
+		jumpClients: jumpClients,
 	}
 
-	bastionEndpoint := net.JoinHostPort(opts.Bastion, strconv.Itoa(opts.BastionPort))
+	// close the connection as soon as the transport is gone, so it's not
+	// reused by the connector
+	go func() {
+		_ = client.Wait()
+		conn.Close()
+	}()
 
-	bastionClient, err := ssh.Dial("tcp", bastionEndpoint, bastionConfig)
-	if err != nil {
-		return nil, fail.SSH(fail.Connection(err, bastionEndpoint), "dialing")
+	if opts.KeepAliveInterval > 0 {
+		go conn.keepAlive(opts.KeepAliveInterval, opts.KeepAliveCountMax)
 	}
 
-	// Dial a connection to the service host, from the bastion
-	endpointBehindBastion := net.JoinHostPort(opts.Hostname, strconv.Itoa(opts.Port))
+	return conn, nil
+}
 
-	conn, err := bastionClient.Dial("tcp", endpointBehindBastion)
+// jumpHostConfig returns the client config of the jump host. When a dedicated
+// jump host key is provided we use only that key so we never waste
+// MaxAuthTries attempts on the node key (which the jump host does not know).
+// If no jump host key is configured we fall back to the node auth methods.
+func jumpHostConfig(jumpHost JumpHostOpts, timeout time.Duration, nodeAuthMethods []ssh.AuthMethod, passphrases *Passphrases) (*ssh.ClientConfig, error) {
+	config := &ssh.ClientConfig{
+		User:    jumpHost.Username,
+		Timeout: timeout,
+		Auth:    nodeAuthMethods,
+	}
+
+	if len(jumpHost.PrivateKey) > 0 {
+		signer, parseErr := parsePrivateKey(jumpHost.PrivateKey, jumpHost.privateKeyName, passphrases)
+		if parseErr != nil {
+			return nil, fail.SSHError{
+				Op:  "parsing private key",
+				Err: errors.Wrap(parseErr, "jump host SSH key could not be parsed"),
+			}
+		}
+		config.Auth = []ssh.AuthMethod{ssh.PublicKeys(signer)}
+	}
+
+	return config, nil
+}
+
+// dialHop connects to the endpoint directly if the jump client is nil,
+// otherwise through the jump client.
+func dialHop(ctx context.Context, jumpClient *ssh.Client, endpoint string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	if jumpClient == nil {
+		client, err := dialContext(ctx, endpoint, config)
+		if err != nil {
+			return nil, fail.SSH(fail.Connection(err, endpoint), "dialing")
+		}
+
+		return client, nil
+	}
+
+	conn, err := jumpClient.Dial("tcp", endpoint)
 	if err != nil {
-		return nil, fail.SSH(fail.Connection(err, endpointBehindBastion), "dialing behind the bastion")
+		return nil, fail.SSH(fail.Connection(err, endpoint), "dialing through the jump host")
 	}
 
-	ncc, chans, reqs, err := ssh.NewClientConn(conn, endpointBehindBastion, nodeConfig)
+	ncc, chans, reqs, err := ssh.NewClientConn(conn, endpoint, config)
 	if err != nil {
-		return nil, fail.SSH(fail.Connection(err, endpointBehindBastion), "new client")
+		conn.Close()
+
+		return nil, fail.SSH(fail.Connection(err, endpoint), "new client")
 	}
 
-	ctx, cancelFn := context.WithCancel(connector.ctx)
+	return ssh.NewClient(ncc, chans, reqs), nil
+}
 
-	return &connection{
-		sshclient: ssh.NewClient(ncc, chans, reqs),
-		connector: connector,
-		ctx:       ctx,
-		cancel:    cancelFn,
-	}, nil
+// dialContext is like ssh.Dial, but gives up dialing once the context is
+// canceled.
+func dialContext(ctx context.Context, endpoint string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	dialer := net.Dialer{Timeout: config.Timeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	ncc, chans, reqs, err := ssh.NewClientConn(conn, endpoint, config)
+	if err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	return ssh.NewClient(ncc, chans, reqs), nil
 }
 
 func hostKeyCallback(knownKey []byte) ssh.HostKeyCallback {
@@ -332,6 +490,73 @@ func hostKeyCallback(knownKey []byte) ssh.HostKeyCallback {
 	}
 }
 
+// keepAlive sends the keepalive requests until the connection is closed. The
+// connection is closed after countMax requests in a row are not answered
+// within the interval.
+func (c *connection) keepAlive(interval time.Duration, countMax int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	missed := 0
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if c.ping(interval) {
+			missed = 0
+
+			continue
+		}
+
+		missed++
+		if missed >= countMax {
+			c.Close()
+
+			return
+		}
+	}
+}
+
+// ping sends the keepalive request and returns true if it's answered within
+// the timeout. The server rejecting the request still proves the connection
+// is alive.
+func (c *connection) ping(timeout time.Duration) bool {
+	c.mu.Lock()
+	client := c.sshclient
+	c.mu.Unlock()
+
+	if client == nil {
+		return false
+	}
+
+	reply := make(chan error, 1)
+	go func() {
+		_, _, err := client.SendRequest(keepAliveRequest, true, nil)
+		reply <- err
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-reply:
+		return err == nil
+	case <-timer.C:
+		return false
+	case <-c.ctx.Done():
+		return false
+	}
+}
+
+// alive returns false once the connection is closed
+func (c *connection) alive() bool {
+	return !c.closed.Load()
+}
+
 func (c *connection) TunnelTo(_ context.Context, network, addr string) (net.Conn, error) {
 	// the voided context.Context is voided as a workaround of always Done
 	// context that being passed. Please don't try to <-ctx.Done(), it will
@@ -363,12 +588,20 @@ func (c *connection) Close() error {
 	if c.sshclient == nil {
 		return nil
 	}
+	c.closed.Store(true)
 	c.cancel()
 
 	defer func() { c.sshclient = nil }()
 	defer c.connector.forgetConnection(c)
 
-	return c.sshclient.Close()
+	err := c.sshclient.Close()
+
+	for i := len(c.jumpClients) - 1; i >= 0; i-- {
+		c.jumpClients[i].Close()
+	}
+	c.jumpClients = nil
+
+	return err
 }
 
 func (c *connection) POpen(cmd string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
@@ -384,6 +617,20 @@ func (c *connection) POpen(cmd string, stdin io.Reader, stdout, stderr io.Writer
 	sess.Stdout = stdout
 	sess.Stderr = stderr
 
+	// kill the remote command if the connection context gets canceled, e.g.
+	// because the user has aborted the run
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			_ = sess.Signal(ssh.SIGKILL)
+			sess.Close()
+		case <-done:
+		}
+	}()
+
 	exitCode := 0
 	if err = sess.Run(cmd); err != nil {
 		exitCode = -1
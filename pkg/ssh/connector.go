@@ -18,16 +18,19 @@ package ssh
 
 import (
 	"context"
+	"net"
 	"path/filepath"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/tracing"
 
 	"k8s.io/client-go/util/homedir"
 )
@@ -37,14 +40,42 @@ type Connector struct {
 	lock        sync.Mutex
 	connections map[int]executor.Interface
 	ctx         context.Context
+	userConfig  *UserConfig
+	hostKeys    hostKeyChecker
+	passphrases *Passphrases
+}
+
+// ConnectorOption configures the Connector
+type ConnectorOption func(*Connector)
+
+// WithUserConfig applies the OpenSSH client configuration to the options of
+// all connections
+func WithUserConfig(cfg *UserConfig) ConnectorOption {
+	return func(c *Connector) {
+		c.userConfig = cfg
+	}
+}
+
+// WithHostKeyVerifier verifies the host keys of all connections against the
+// known_hosts files
+func WithHostKeyVerifier(v *HostKeyVerifier) ConnectorOption {
+	return func(c *Connector) {
+		c.hostKeys = v
+	}
 }
 
 // NewConnector constructor
-func NewConnector(ctx context.Context) *Connector {
-	return &Connector{
+func NewConnector(ctx context.Context, opts ...ConnectorOption) *Connector {
+	c := &Connector{
 		connections: make(map[int]executor.Interface),
 		ctx:         ctx,
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // Tunnel returns established SSH tunnel
@@ -67,26 +98,110 @@ func (c *Connector) Tunnel(host kubeoneapi.HostConfig) (executor.Tunneler, error
 
 // Open to the node
 func (c *Connector) Open(host kubeoneapi.HostConfig) (executor.Interface, error) {
-	var err error
+	return c.OpenContext(c.ctx, host)
+}
 
+// OpenContext opens the connection to the node, tracing it as a child of the
+// span in the given context. The connection itself is bound to the context of
+// the connector.
+func (c *Connector) OpenContext(ctx context.Context, host kubeoneapi.HostConfig) (_ executor.Interface, err error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	// the dead connection is replaced with the new one transparently
 	conn, found := c.connections[host.ID]
-	if !found {
-		opts := sshOpts(host)
-		opts.Context = c.ctx
-		conn, err = NewConnection(c, opts)
-		if err != nil {
-			return nil, err
-		}
+	if found && connectionAlive(conn) {
+		return conn, nil
+	}
 
-		c.connections[host.ID] = conn
+	_, span := tracing.Start(ctx, "ssh connect", tracing.NodeKey.String(host.PublicAddress))
+	defer func() { tracing.End(span, err) }()
+
+	conn, err = NewConnection(c, c.connectionOpts(host))
+	if err != nil {
+		return nil, err
 	}
 
+	c.connections[host.ID] = conn
+
 	return conn, nil
 }
 
+// HostKeys are the host keys of the node and the jump hosts it's reached
+// through, in the wire format used in the KubeOne config
+type HostKeys struct {
+	Host      []byte
+	JumpHosts [][]byte
+}
+
+// ScanHostKeys returns the host keys presented by the node and its jump
+// hosts without verifying them. The node is only required to complete the
+// key exchange, while the jump hosts must accept the configured credentials.
+func (c *Connector) ScanHostKeys(host kubeoneapi.HostConfig) (*HostKeys, error) {
+	opts := c.connectionOpts(host)
+
+	hops := len(opts.JumpHosts)
+	if hops == 0 && opts.Bastion != "" {
+		hops = 1
+	}
+
+	recorder := &hostKeyRecorder{}
+	opts.hostKeys = recorder
+
+	conn, err := NewConnection(c, opts)
+	if err == nil {
+		conn.Close()
+	}
+
+	// the node key is recorded even if the authentication fails afterwards
+	if len(recorder.keys) < hops+1 {
+		if err == nil {
+			err = errors.New("host key has not been presented")
+		}
+
+		return nil, fail.SSH(err, "scanning host keys of %s", host.PublicAddress)
+	}
+
+	return &HostKeys{
+		Host:      recorder.keys[hops],
+		JumpHosts: recorder.keys[:hops],
+	}, nil
+}
+
+// hostKeyRecorder accepts all host keys, recording them in the dial order
+type hostKeyRecorder struct {
+	keys [][]byte
+}
+
+func (r *hostKeyRecorder) configure(config *ssh.ClientConfig, _ string, _ []byte) {
+	config.HostKeyCallback = func(_ string, _ net.Addr, key ssh.PublicKey) error {
+		r.keys = append(r.keys, key.Marshal())
+
+		return nil
+	}
+}
+
+// connectionOpts returns the options of the connection to the host
+func (c *Connector) connectionOpts(host kubeoneapi.HostConfig) Opts {
+	opts := sshOpts(host)
+	opts.Context = c.ctx
+	opts.hostKeys = c.hostKeys
+
+	if c.userConfig != nil {
+		opts = c.userConfig.Apply(opts)
+	}
+
+	return opts
+}
+
+func connectionAlive(conn executor.Interface) bool {
+	if sshConn, ok := conn.(*connection); ok {
+		return sshConn.alive()
+	}
+
+	return true
+}
+
 func (c *Connector) forgetConnection(conn *connection) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -113,7 +228,24 @@ func sshOpts(host kubeoneapi.HostConfig) Opts {
 		BastionUser:           host.BastionUser,
 		BastionHostPublicKey:  host.BastionHostPublicKey,
 		BastionPrivateKeyFile: resolveHomeDir(host.BastionPrivateKeyFile),
+		JumpHosts:             jumpHostOpts(host.JumpHosts),
+	}
+}
+
+func jumpHostOpts(jumpHosts []kubeoneapi.JumpHost) []JumpHostOpts {
+	var opts []JumpHostOpts
+
+	for _, jumpHost := range jumpHosts {
+		opts = append(opts, JumpHostOpts{
+			Hostname:       jumpHost.Address,
+			Port:           jumpHost.Port,
+			Username:       jumpHost.User,
+			HostPublicKey:  jumpHost.HostPublicKey,
+			PrivateKeyFile: resolveHomeDir(jumpHost.PrivateKeyFile),
+		})
 	}
+
+	return opts
 }
 
 func resolveHomeDir(path string) string {
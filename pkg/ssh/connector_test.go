@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+)
+
+// testServer is the SSH server running the "exit N" commands
+type testServer struct {
+	listener net.Listener
+	config   *ssh.ServerConfig
+
+	lock  sync.Mutex
+	conns []net.Conn
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(ssh.ConnMetadata, ssh.PublicKey) (*ssh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	srv := &testServer{listener: listener, config: config}
+	go srv.serve()
+
+	return srv
+}
+
+func (srv *testServer) serve() {
+	for {
+		conn, err := srv.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		srv.lock.Lock()
+		srv.conns = append(srv.conns, conn)
+		srv.lock.Unlock()
+
+		go srv.handle(conn)
+	}
+}
+
+func (srv *testServer) handle(conn net.Conn) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, srv.config)
+	if err != nil {
+		return
+	}
+
+	// keepalive requests are answered by rejecting them, like OpenSSH does
+	go ssh.DiscardRequests(reqs)
+
+	for newChan := range chans {
+		channel, requests, err := newChan.Accept()
+		if err != nil {
+			continue
+		}
+
+		go func() {
+			defer channel.Close()
+
+			for req := range requests {
+				if req.Type != "exec" {
+					_ = req.Reply(false, nil)
+
+					continue
+				}
+
+				_ = req.Reply(true, nil)
+
+				// the payload is the length-prefixed command
+				var status uint32
+				if cmd := string(req.Payload[4:]); strings.HasPrefix(cmd, "exit ") {
+					status = uint32(cmd[len(cmd)-1] - '0')
+				}
+
+				payload := make([]byte, 4)
+				binary.BigEndian.PutUint32(payload, status)
+				_, _ = channel.SendRequest("exit-status", false, payload)
+
+				return
+			}
+		}()
+	}
+}
+
+// dropConnections closes all connections on the server side
+func (srv *testServer) dropConnections() {
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+
+	for _, conn := range srv.conns {
+		conn.Close()
+	}
+	srv.conns = nil
+}
+
+func testHost(t *testing.T, srv *testServer) kubeoneapi.HostConfig {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	addr := srv.listener.Addr().(*net.TCPAddr)
+
+	return kubeoneapi.HostConfig{
+		PublicAddress:     addr.IP.String(),
+		SSHPort:           addr.Port,
+		SSHUsername:       "root",
+		SSHPrivateKeyFile: keyFile,
+	}
+}
+
+func TestConnectorExitErrorKeepsConnection(t *testing.T) {
+	srv := newTestServer(t)
+	connector := NewConnector(context.Background())
+
+	conn, err := connector.Open(testHost(t, srv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, _, exitCode, execErr := conn.Exec("exit 3"); exitCode != 3 || !executor.IsExitError(execErr) {
+		t.Fatalf("Exec() = %d, %v, want the exit error with the exit code 3", exitCode, execErr)
+	}
+
+	if _, _, _, err = conn.Exec("true"); err != nil {
+		t.Errorf("connection unusable after the failed command: %v", err)
+	}
+}
+
+func TestConnectorReconnects(t *testing.T) {
+	srv := newTestServer(t)
+	connector := NewConnector(context.Background())
+	host := testHost(t, srv)
+
+	conn, err := connector.Open(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.dropConnections()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for connectionAlive(conn) {
+		if time.Now().After(deadline) {
+			t.Fatal("dropped connection not detected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, _, _, err = conn.Exec("true"); err == nil || executor.IsExitError(err) {
+		t.Errorf("Exec() on the dropped connection = %v, want the transport error", err)
+	}
+
+	reconnected, err := connector.Open(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reconnected.Close()
+
+	if reconnected == conn {
+		t.Fatal("dropped connection reused")
+	}
+
+	if _, _, _, err = reconnected.Exec("true"); err != nil {
+		t.Errorf("Exec() on the new connection: %v", err)
+	}
+}
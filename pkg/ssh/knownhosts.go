@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// Host key checking modes
+const (
+	// HostKeyCheckingOff verifies only the host keys set in the KubeOne config
+	HostKeyCheckingOff = "off"
+	// HostKeyCheckingStrict requires the host keys to be set in the KubeOne
+	// config or in the known_hosts files
+	HostKeyCheckingStrict = "strict"
+	// HostKeyCheckingAcceptNew records the keys of the unknown hosts in the
+	// KubeOne known_hosts file on the first connection (trust on first use)
+	HostKeyCheckingAcceptNew = "accept-new"
+)
+
+const (
+	// DefaultKnownHostsFile is the known_hosts file of the OpenSSH client
+	DefaultKnownHostsFile = "~/.ssh/known_hosts"
+	// ManagedKnownHostsFile is the known_hosts file the host keys accepted by
+	// KubeOne are recorded to
+	ManagedKnownHostsFile = "~/.kubeone/known_hosts"
+)
+
+// HostKeyCheckingModes lists all host key checking modes
+var HostKeyCheckingModes = []string{
+	HostKeyCheckingOff,
+	HostKeyCheckingStrict,
+	HostKeyCheckingAcceptNew,
+}
+
+// hostKeyChecker configures verification of the host key of the endpoint,
+// knownKey is the key set in the KubeOne config, if any
+type hostKeyChecker interface {
+	configure(config *ssh.ClientConfig, endpoint string, knownKey []byte)
+}
+
+// pinnedHostKeys verifies only the host keys set in the KubeOne config
+type pinnedHostKeys struct{}
+
+func (pinnedHostKeys) configure(config *ssh.ClientConfig, _ string, knownKey []byte) {
+	config.HostKeyCallback = ssh.InsecureIgnoreHostKey() //nolint:gosec
+
+	if knownKey != nil {
+		config.HostKeyCallback = hostKeyCallback(knownKey)
+	}
+}
+
+// HostKeyVerifier verifies the host keys against the known_hosts files. The
+// host keys set in the KubeOne config take precedence over the files.
+type HostKeyVerifier struct {
+	mode string
+	// files are read, managedFile is read and written
+	files       []string
+	managedFile string
+
+	// lock serializes recording of the new keys
+	lock sync.Mutex
+}
+
+// NewHostKeyVerifier returns the verifier of the host keys in the given mode,
+// reading the known_hosts files and the managed file, which the new host keys
+// are recorded to in the accept-new mode.
+func NewHostKeyVerifier(mode string, files []string, managedFile string) (*HostKeyVerifier, error) {
+	if !slices.Contains(HostKeyCheckingModes, mode) {
+		return nil, fail.NewConfigError("host key checking", "unknown mode %q, known modes are: %s", mode, strings.Join(HostKeyCheckingModes, ", "))
+	}
+
+	v := &HostKeyVerifier{
+		mode:        mode,
+		managedFile: resolveHomeDir(managedFile),
+	}
+
+	for _, file := range files {
+		v.files = append(v.files, resolveHomeDir(file))
+	}
+
+	return v, nil
+}
+
+func (v *HostKeyVerifier) configure(config *ssh.ClientConfig, endpoint string, knownKey []byte) {
+	if knownKey != nil || v.mode == HostKeyCheckingOff {
+		pinnedHostKeys{}.configure(config, endpoint, knownKey)
+
+		return
+	}
+
+	config.HostKeyCallback = v.verify
+
+	// prefer the algorithms of the known keys, otherwise the server may
+	// present the key of the other type and fail the verification
+	if algorithms := v.knownAlgorithms(endpoint); len(algorithms) > 0 {
+		config.HostKeyAlgorithms = algorithms
+	}
+}
+
+// verify is the ssh.HostKeyCallback checking the key against the known_hosts
+// files
+func (v *HostKeyVerifier) verify(hostname string, remote net.Addr, key ssh.PublicKey) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	err := v.check(hostname, remote, key)
+
+	var keyErr *knownhosts.KeyError
+	if err == nil || !errors.As(err, &keyErr) {
+		return fail.NonRetryable(err)
+	}
+
+	if len(keyErr.Want) > 0 {
+		var known []string
+		for _, want := range keyErr.Want {
+			known = append(known, fmt.Sprintf("%s:%d", want.Filename, want.Line))
+		}
+
+		return fail.NonRetryable(fmt.Errorf(
+			"host key %s %s of %s has CHANGED, it doesn't match the key(s) at %s; someone could be eavesdropping on the connection, "+
+				"remove the old key(s) only if the host has been reinstalled",
+			key.Type(), ssh.FingerprintSHA256(key), hostname, strings.Join(known, ", ")))
+	}
+
+	if v.mode == HostKeyCheckingStrict {
+		return fail.NonRetryable(fmt.Errorf(
+			"host key %s %s of %s is unknown; add it to the known_hosts file, run 'kubeone ssh-keyscan' or use '--ssh-host-key-checking=%s'",
+			key.Type(), ssh.FingerprintSHA256(key), hostname, HostKeyCheckingAcceptNew))
+	}
+
+	return v.record(hostname, key)
+}
+
+// check checks the key against all existing known_hosts files
+func (v *HostKeyVerifier) check(hostname string, remote net.Addr, key ssh.PublicKey) error {
+	var files []string
+	for _, file := range append(slices.Clone(v.files), v.managedFile) {
+		if _, err := os.Stat(file); err == nil {
+			files = append(files, file)
+		}
+	}
+
+	callback, err := knownhosts.New(files...)
+	if err != nil {
+		return fail.Config(err, "reading known_hosts")
+	}
+
+	return callback(hostname, remote, key)
+}
+
+// record appends the key of the host to the managed known_hosts file
+func (v *HostKeyVerifier) record(hostname string, key ssh.PublicKey) error {
+	if err := os.MkdirAll(filepath.Dir(v.managedFile), 0o700); err != nil {
+		return fail.Runtime(err, "creating known_hosts directory")
+	}
+
+	f, err := os.OpenFile(v.managedFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fail.Runtime(err, "opening known_hosts")
+	}
+	defer f.Close()
+
+	if _, err = fmt.Fprintln(f, knownhosts.Line([]string{knownhosts.Normalize(hostname)}, key)); err != nil {
+		return fail.Runtime(err, "recording host key to %s", v.managedFile)
+	}
+
+	return nil
+}
+
+// knownAlgorithms returns the host key algorithms of the keys of the endpoint
+// in the known_hosts files
+func (v *HostKeyVerifier) knownAlgorithms(endpoint string) []string {
+	// the placeholder key never matches, so all known keys of the endpoint
+	// are reported in the error
+	placeholder, err := ssh.NewPublicKey(ed25519.PublicKey(make([]byte, ed25519.PublicKeySize)))
+	if err != nil {
+		return nil
+	}
+
+	v.lock.Lock()
+	err = v.check(endpoint, &net.TCPAddr{}, placeholder)
+	v.lock.Unlock()
+
+	var keyErr *knownhosts.KeyError
+	if !errors.As(err, &keyErr) {
+		return nil
+	}
+
+	var algorithms []string
+	for _, want := range keyErr.Want {
+		for _, algorithm := range keyAlgorithms(want.Key.Type()) {
+			if !slices.Contains(algorithms, algorithm) {
+				algorithms = append(algorithms, algorithm)
+			}
+		}
+	}
+
+	return algorithms
+}
+
+// keyAlgorithms returns the signature algorithms of the key type
+func keyAlgorithms(keyType string) []string {
+	if keyType == ssh.KeyAlgoRSA {
+		return []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}
+	}
+
+	return []string{keyType}
+}
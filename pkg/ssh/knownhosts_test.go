@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+func newTestHostKey(t *testing.T) ssh.PublicKey {
+	t.Helper()
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func TestHostKeyVerifier(t *testing.T) {
+	const endpoint = "10.0.0.1:22"
+
+	var (
+		remote   = &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 22}
+		knownKey = newTestHostKey(t)
+		otherKey = newTestHostKey(t)
+	)
+
+	dir := t.TempDir()
+	knownHosts := filepath.Join(dir, "known_hosts")
+	managed := filepath.Join(dir, "kubeone", "known_hosts")
+
+	line := knownhosts.Line([]string{"10.0.0.2"}, knownKey) + "\n"
+	if err := os.WriteFile(knownHosts, []byte(line), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	strict, err := NewHostKeyVerifier(HostKeyCheckingStrict, []string{knownHosts}, managed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = strict.verify("10.0.0.2:22", remote, knownKey); err != nil {
+		t.Errorf("strict mode rejected the known key: %v", err)
+	}
+
+	if err = strict.verify(endpoint, remote, knownKey); err == nil || fail.Retryable(err) {
+		t.Errorf("strict mode accepted the unknown host: %v", err)
+	}
+
+	acceptNew, err := NewHostKeyVerifier(HostKeyCheckingAcceptNew, []string{knownHosts}, managed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = acceptNew.verify(endpoint, remote, knownKey); err != nil {
+		t.Fatalf("accept-new mode rejected the unknown host: %v", err)
+	}
+
+	if err = acceptNew.verify(endpoint, remote, knownKey); err != nil {
+		t.Errorf("accept-new mode rejected the recorded key: %v", err)
+	}
+
+	if err = acceptNew.verify(endpoint, remote, otherKey); err == nil || fail.Retryable(err) {
+		t.Errorf("accept-new mode accepted the changed key: %v", err)
+	}
+
+	if err = strict.verify(endpoint, remote, knownKey); err != nil {
+		t.Errorf("strict mode rejected the key recorded by accept-new mode: %v", err)
+	}
+
+	if got, want := strict.knownAlgorithms(endpoint), []string{ssh.KeyAlgoED25519}; !reflect.DeepEqual(got, want) {
+		t.Errorf("knownAlgorithms() = %v, want %v", got, want)
+	}
+
+	if got := strict.knownAlgorithms("10.0.0.3:22"); got != nil {
+		t.Errorf("knownAlgorithms() of the unknown host = %v, want nil", got)
+	}
+
+	if _, err = NewHostKeyVerifier("ask", nil, managed); err == nil {
+		t.Error("unknown mode accepted")
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// PassphraseEnv is the environment variable with the passphrase of the
+// private keys
+const PassphraseEnv = "KUBEONE_SSH_KEY_PASSPHRASE"
+
+// Passphrases decrypts the passphrase-protected private keys. The passphrase
+// is read from the file, if set, from the PassphraseEnv environment variable,
+// or asked for in the terminal, once for each key. The decrypted keys are
+// cached for the run, so the concurrent connections to many hosts share them.
+type Passphrases struct {
+	// passphrase is read from the file or the environment, and used for all
+	// keys
+	passphrase []byte
+	// prompt asks for the passphrase of the key, nil if not running in the
+	// terminal
+	prompt func(keyName string) ([]byte, error)
+
+	lock    sync.Mutex
+	signers map[[sha256.Size]byte]ssh.Signer
+}
+
+// NewPassphrases returns the passphrases read from the file, if set,
+// otherwise from the environment or the terminal
+func NewPassphrases(file string) (*Passphrases, error) {
+	p := &Passphrases{
+		signers: map[[sha256.Size]byte]ssh.Signer{},
+	}
+
+	switch {
+	case file != "":
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fail.Config(err, "reading SSH key passphrase")
+		}
+
+		p.passphrase = []byte(strings.TrimRight(string(buf), "\r\n"))
+	case os.Getenv(PassphraseEnv) != "":
+		p.passphrase = []byte(os.Getenv(PassphraseEnv))
+	case term.IsTerminal(int(os.Stdin.Fd())):
+		p.prompt = promptPassphrase
+	}
+
+	return p, nil
+}
+
+// WithPassphrases decrypts the passphrase-protected private keys of all
+// connections
+func WithPassphrases(p *Passphrases) ConnectorOption {
+	return func(c *Connector) {
+		c.passphrases = p
+	}
+}
+
+// signer returns the signer of the passphrase-protected key. The lock is held
+// while asking for the passphrase, so the concurrent connections using the
+// same key ask only once.
+func (p *Passphrases) signer(key []byte, keyName string) (ssh.Signer, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	sum := sha256.Sum256(key)
+	if signer, ok := p.signers[sum]; ok {
+		return signer, nil
+	}
+
+	passphrase := p.passphrase
+	if passphrase == nil {
+		if p.prompt == nil {
+			return nil, errors.Errorf("%s is passphrase-protected, set the passphrase with --ssh-key-passphrase-file or %s", keyName, PassphraseEnv)
+		}
+
+		var err error
+		if passphrase, err = p.prompt(keyName); err != nil {
+			return nil, err
+		}
+	}
+
+	signer, err := ssh.ParsePrivateKeyWithPassphrase(key, passphrase)
+	if err != nil {
+		if errors.Is(err, x509.IncorrectPasswordError) {
+			return nil, errors.Errorf("incorrect passphrase for %s", keyName)
+		}
+
+		return nil, err
+	}
+
+	p.signers[sum] = signer
+
+	return signer, nil
+}
+
+func promptPassphrase(keyName string) ([]byte, error) {
+	fmt.Fprintf(os.Stderr, "Enter passphrase for %s: ", keyName)
+	defer fmt.Fprintln(os.Stderr)
+
+	passphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+	if err != nil {
+		return nil, fail.Runtime(err, "reading SSH key passphrase")
+	}
+
+	return passphrase, nil
+}
+
+// parsePrivateKey parses the private key, decrypting it with the passphrases
+// if it's passphrase-protected
+func parsePrivateKey(key []byte, keyName string, passphrases *Passphrases) (ssh.Signer, error) {
+	if keyName == "" {
+		keyName = "SSH private key"
+	}
+
+	signer, err := ssh.ParsePrivateKey(key)
+
+	var missingErr *ssh.PassphraseMissingError
+	if !errors.As(err, &missingErr) {
+		return signer, err
+	}
+
+	if passphrases == nil {
+		return nil, errors.Errorf("%s is passphrase-protected", keyName)
+	}
+
+	return passphrases.signer(key, keyName)
+}
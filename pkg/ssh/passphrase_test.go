@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+// encryptKeyFile replaces the private key of the host with the one protected
+// with the passphrase
+func encryptKeyFile(t *testing.T, host kubeoneapi.HostConfig, passphrase string) {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(key, "", []byte(passphrase))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = os.WriteFile(host.SSHPrivateKeyFile, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPassphrasesPromptOnce(t *testing.T) {
+	srv := newTestServer(t)
+	host := testHost(t, srv)
+	encryptKeyFile(t, host, "secret")
+
+	var prompts atomic.Int32
+	passphrases := &Passphrases{
+		prompt: func(keyName string) ([]byte, error) {
+			prompts.Add(1)
+
+			if keyName != host.SSHPrivateKeyFile {
+				t.Errorf("asked for the passphrase of %q, want %q", keyName, host.SSHPrivateKeyFile)
+			}
+
+			return []byte("secret"), nil
+		},
+		signers: map[[sha256.Size]byte]ssh.Signer{},
+	}
+	connector := NewConnector(context.Background(), WithPassphrases(passphrases))
+
+	var wg sync.WaitGroup
+	for id := range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			host := host
+			host.ID = id
+
+			conn, err := connector.Open(host)
+			if err != nil {
+				t.Error(err)
+
+				return
+			}
+			defer conn.Close()
+
+			if _, _, _, err = conn.Exec("exit 0"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := prompts.Load(); got != 1 {
+		t.Errorf("asked for the passphrase %d times, want once", got)
+	}
+}
+
+func TestPassphrasesFile(t *testing.T) {
+	srv := newTestServer(t)
+	host := testHost(t, srv)
+	encryptKeyFile(t, host, "secret")
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	if err := os.WriteFile(passphraseFile, []byte("secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	passphrases, err := NewPassphrases(passphraseFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := NewConnector(context.Background(), WithPassphrases(passphrases)).Open(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
+
+func TestPassphrasesEnv(t *testing.T) {
+	srv := newTestServer(t)
+	host := testHost(t, srv)
+	encryptKeyFile(t, host, "secret")
+
+	t.Setenv(PassphraseEnv, "wrong")
+
+	passphrases, err := NewPassphrases("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewConnector(context.Background(), WithPassphrases(passphrases)).Open(host)
+	if err == nil || !strings.Contains(err.Error(), "incorrect passphrase") {
+		t.Errorf("Open() = %v, want the incorrect passphrase error", err)
+	}
+}
+
+func TestPassphrasesNotConfigured(t *testing.T) {
+	srv := newTestServer(t)
+	host := testHost(t, srv)
+	encryptKeyFile(t, host, "secret")
+
+	_, err := NewConnector(context.Background()).Open(host)
+	if err == nil || !strings.Contains(err.Error(), "passphrase-protected") {
+		t.Errorf("Open() = %v, want the passphrase-protected key error", err)
+	}
+}
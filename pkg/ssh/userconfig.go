@@ -0,0 +1,472 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"k8c.io/kubeone/pkg/fail"
+
+	"k8s.io/client-go/util/homedir"
+)
+
+const (
+	// defaultUsername and defaultPort are the defaults of the KubeOne API,
+	// which are overridden by the user SSH config
+	defaultUsername = "root"
+	defaultPort     = 22
+
+	// maxUserConfigDepth limits the nested Include directives and ProxyJump
+	// chains of the jump hosts
+	maxUserConfigDepth = 16
+)
+
+// UserConfig is the subset of the OpenSSH client configuration (see
+// ssh_config(5)) used when connecting to the hosts: the Host blocks with
+// HostName, User, Port, IdentityFile and ProxyJump, and Include. Match blocks
+// and all other keywords are ignored.
+type UserConfig struct {
+	blocks []*userConfigBlock
+}
+
+type userConfigBlock struct {
+	patterns []string
+
+	hostName     string
+	user         string
+	port         int
+	identityFile string
+	proxyJump    []JumpHostOpts
+	// proxyJumpSet is true if ProxyJump is set, including to "none"
+	proxyJumpSet bool
+}
+
+// userConfigHost are the settings of the host resolved from the matching
+// blocks, the first obtained value of each setting is used
+type userConfigHost struct {
+	hostName     string
+	user         string
+	port         int
+	identityFile string
+	proxyJump    []JumpHostOpts
+}
+
+// LoadUserConfig loads the OpenSSH client configuration file, usually
+// ~/.ssh/config.
+func LoadUserConfig(path string) (*UserConfig, error) {
+	cfg := &UserConfig{}
+	if err := cfg.load(resolveHomeDir(path), 0); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c *UserConfig) load(path string, depth int) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fail.Config(err, "opening SSH config")
+	}
+	defer f.Close()
+
+	return c.parse(f, depth)
+}
+
+func (c *UserConfig) parse(r io.Reader, depth int) error {
+	if depth > maxUserConfigDepth {
+		return fail.ConfigValidation(errors.New("too many nested SSH config includes"))
+	}
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		keyword, args := splitUserConfigLine(scanner.Text())
+		if keyword == "" {
+			continue
+		}
+
+		if len(args) == 0 {
+			return fail.ConfigValidation(errors.Errorf("SSH config line %d: missing argument of %s", lineNo, keyword))
+		}
+
+		if err := c.set(keyword, args, depth); err != nil {
+			return fail.ConfigValidation(errors.Wrapf(err, "SSH config line %d", lineNo))
+		}
+	}
+
+	return fail.Config(scanner.Err(), "reading SSH config")
+}
+
+func (c *UserConfig) set(keyword string, args []string, depth int) error {
+	switch keyword {
+	case "host":
+		c.blocks = append(c.blocks, &userConfigBlock{patterns: args})
+
+		return nil
+	case "match":
+		// Match criteria are not supported, the block never matches
+		c.blocks = append(c.blocks, &userConfigBlock{})
+
+		return nil
+	case "include":
+		for _, pattern := range args {
+			pattern = resolveHomeDir(pattern)
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(homedir.HomeDir(), ".ssh", pattern)
+			}
+
+			paths, err := filepath.Glob(pattern)
+			if err != nil {
+				return err
+			}
+
+			for _, path := range paths {
+				if err = c.load(path, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// the settings before the first Host block apply to all hosts
+	if len(c.blocks) == 0 {
+		c.blocks = append(c.blocks, &userConfigBlock{patterns: []string{"*"}})
+	}
+
+	// the first obtained value is used, also within the block
+	block := c.blocks[len(c.blocks)-1]
+
+	switch keyword {
+	case "hostname":
+		if block.hostName == "" {
+			block.hostName = args[0]
+		}
+	case "user":
+		if block.user == "" {
+			block.user = args[0]
+		}
+	case "port":
+		port, err := strconv.Atoi(args[0])
+		if err != nil || port <= 0 || port > 65535 {
+			return errors.Errorf("invalid port %q", args[0])
+		}
+		if block.port == 0 {
+			block.port = port
+		}
+	case "identityfile":
+		if block.identityFile == "" {
+			block.identityFile = args[0]
+		}
+	case "proxyjump":
+		jumpHosts, err := parseProxyJump(args[0])
+		if err != nil {
+			return err
+		}
+		if !block.proxyJumpSet {
+			block.proxyJump = jumpHosts
+			block.proxyJumpSet = true
+		}
+	}
+
+	return nil
+}
+
+// parseProxyJump parses the comma separated list of [user@]host[:port]
+func parseProxyJump(value string) ([]JumpHostOpts, error) {
+	if strings.EqualFold(value, "none") {
+		return nil, nil
+	}
+
+	var jumpHosts []JumpHostOpts
+	for _, hop := range strings.Split(value, ",") {
+		jumpHost := JumpHostOpts{Hostname: hop}
+
+		if user, host, found := strings.Cut(hop, "@"); found {
+			jumpHost.Username = user
+			jumpHost.Hostname = host
+		}
+
+		if host, port, err := net.SplitHostPort(jumpHost.Hostname); err == nil {
+			portNum, convErr := strconv.Atoi(port)
+			if convErr != nil || portNum <= 0 || portNum > 65535 {
+				return nil, errors.Errorf("invalid port in ProxyJump %q", hop)
+			}
+			jumpHost.Hostname = host
+			jumpHost.Port = portNum
+		}
+
+		if jumpHost.Hostname == "" {
+			return nil, errors.Errorf("invalid ProxyJump %q", hop)
+		}
+
+		jumpHosts = append(jumpHosts, jumpHost)
+	}
+
+	return jumpHosts, nil
+}
+
+// splitUserConfigLine returns the lowercase keyword and the arguments of the
+// line, which can be separated by whitespace or "=", and quoted
+func splitUserConfigLine(line string) (string, []string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", nil
+	}
+
+	end := strings.IndexAny(line, " \t=")
+	if end < 0 {
+		return strings.ToLower(line), nil
+	}
+
+	keyword := strings.ToLower(line[:end])
+	rest := strings.TrimSpace(line[end:])
+	rest = strings.TrimSpace(strings.TrimPrefix(rest, "="))
+
+	var (
+		args   []string
+		arg    strings.Builder
+		quoted bool
+		inArg  bool
+	)
+
+	for _, r := range rest {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inArg = true
+		case !quoted && (r == ' ' || r == '\t'):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		args = append(args, arg.String())
+	}
+
+	return keyword, args
+}
+
+// lookup resolves the settings of the host from all matching blocks
+func (c *UserConfig) lookup(host string) userConfigHost {
+	var (
+		settings     userConfigHost
+		proxyJumpSet bool
+	)
+
+	for _, block := range c.blocks {
+		if !matchHostPatterns(block.patterns, host) {
+			continue
+		}
+
+		if settings.hostName == "" {
+			settings.hostName = block.hostName
+		}
+		if settings.user == "" {
+			settings.user = block.user
+		}
+		if settings.port == 0 {
+			settings.port = block.port
+		}
+		if settings.identityFile == "" {
+			settings.identityFile = block.identityFile
+		}
+		if !proxyJumpSet && block.proxyJumpSet {
+			settings.proxyJump = block.proxyJump
+			proxyJumpSet = true
+		}
+	}
+
+	if settings.hostName != "" {
+		settings.hostName = expandUserConfigTokens(settings.hostName, host, "")
+	}
+
+	return settings
+}
+
+// Apply returns the options updated with the settings of the host from the
+// SSH config. HostName and the settings of the jump hosts are always applied,
+// while User, Port, IdentityFile and ProxyJump are only applied if the
+// options have the defaults of the KubeOne API.
+func (c *UserConfig) Apply(o Opts) Opts {
+	settings := c.lookup(o.Hostname)
+
+	if settings.hostName != "" {
+		o.Hostname = settings.hostName
+	}
+
+	if settings.user != "" && (o.Username == "" || o.Username == defaultUsername) {
+		o.Username = settings.user
+	}
+
+	if settings.port != 0 && (o.Port == 0 || o.Port == defaultPort) {
+		o.Port = settings.port
+	}
+
+	if settings.identityFile != "" && o.KeyFile == "" && len(o.PrivateKey) == 0 {
+		o.KeyFile = expandUserConfigTokens(settings.identityFile, o.Hostname, o.Username)
+	}
+
+	jumpHosts := o.JumpHosts
+	if len(jumpHosts) == 0 && o.Bastion == "" {
+		jumpHosts = settings.proxyJump
+	}
+
+	o.JumpHosts = c.resolveJumpHosts(jumpHosts, 0)
+
+	return o
+}
+
+// resolveJumpHosts applies the SSH config to the jump hosts. ProxyJump of the
+// first jump host is prepended to the chain, like OpenSSH does.
+func (c *UserConfig) resolveJumpHosts(jumpHosts []JumpHostOpts, depth int) []JumpHostOpts {
+	if len(jumpHosts) == 0 || depth > maxUserConfigDepth {
+		return jumpHosts
+	}
+
+	resolved := make([]JumpHostOpts, 0, len(jumpHosts))
+
+	for idx, jumpHost := range jumpHosts {
+		settings := c.lookup(jumpHost.Hostname)
+
+		if idx == 0 && len(settings.proxyJump) > 0 {
+			resolved = append(resolved, c.resolveJumpHosts(settings.proxyJump, depth+1)...)
+		}
+
+		if settings.hostName != "" {
+			jumpHost.Hostname = settings.hostName
+		}
+		if jumpHost.Username == "" {
+			jumpHost.Username = settings.user
+		}
+		if jumpHost.Port == 0 {
+			jumpHost.Port = settings.port
+		}
+		if settings.identityFile != "" && jumpHost.PrivateKeyFile == "" && len(jumpHost.PrivateKey) == 0 {
+			jumpHost.PrivateKeyFile = expandUserConfigTokens(settings.identityFile, jumpHost.Hostname, jumpHost.Username)
+		}
+
+		resolved = append(resolved, jumpHost)
+	}
+
+	return resolved
+}
+
+// expandUserConfigTokens expands ~ and the %d (home directory), %h (host),
+// %r (remote user) and %% tokens
+func expandUserConfigTokens(value, host, user string) string {
+	value = resolveHomeDir(value)
+	if !strings.Contains(value, "%") {
+		return value
+	}
+
+	var out strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '%' || i+1 == len(value) {
+			out.WriteByte(value[i])
+
+			continue
+		}
+
+		i++
+		switch value[i] {
+		case 'd':
+			out.WriteString(homedir.HomeDir())
+		case 'h':
+			out.WriteString(host)
+		case 'r':
+			out.WriteString(user)
+		case '%':
+			out.WriteByte('%')
+		default:
+			out.WriteByte('%')
+			out.WriteByte(value[i])
+		}
+	}
+
+	return out.String()
+}
+
+// matchHostPatterns reports whether the host matches any of the patterns and
+// none of the negated (!) ones
+func matchHostPatterns(patterns []string, host string) bool {
+	host = strings.ToLower(host)
+	matched := false
+
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+
+		if negated, ok := strings.CutPrefix(pattern, "!"); ok {
+			if matchWildcard(negated, host) {
+				return false
+			}
+
+			continue
+		}
+
+		if matchWildcard(pattern, host) {
+			matched = true
+		}
+	}
+
+	return matched
+}
+
+// matchWildcard matches the string against the pattern with the * and ?
+// wildcards
+func matchWildcard(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(s); i >= 0; i-- {
+				if matchWildcard(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+
+			return false
+		case '?':
+			if s == "" {
+				return false
+			}
+		default:
+			if s == "" || s[0] != pattern[0] {
+				return false
+			}
+		}
+
+		pattern = pattern[1:]
+		s = s[1:]
+	}
+
+	return s == ""
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testUserConfig = `
+# global settings apply to all hosts
+IdentityFile /keys/%h_%r
+
+Host bastion-2
+    HostName 10.0.0.2
+    User jump
+    ProxyJump bastion-1
+
+Host bastion-1
+    HostName=bastion.example.com
+    Port 2222
+
+Host *.internal !db.internal
+    User admin
+    Port 2200
+    User ignored
+    ProxyJump bastion-2,ops@10.0.0.3:2022
+
+Host db.internal
+    ProxyJump none
+
+Match host *.internal
+    User matched
+
+Host *
+    User fallback
+`
+
+func TestUserConfigApply(t *testing.T) {
+	cfg := &UserConfig{}
+	if err := cfg.parse(strings.NewReader(testUserConfig), 0); err != nil {
+		t.Fatalf("parsing SSH config: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opts Opts
+		want Opts
+	}{
+		{
+			name: "defaults are overridden",
+			opts: Opts{Hostname: "node.internal", Username: "root", Port: 22},
+			want: Opts{
+				Hostname: "node.internal",
+				Username: "admin",
+				Port:     2200,
+				KeyFile:  "/keys/node.internal_admin",
+				JumpHosts: []JumpHostOpts{
+					{Hostname: "bastion.example.com", Port: 2222, Username: "fallback", PrivateKeyFile: "/keys/bastion.example.com_fallback"},
+					{Hostname: "10.0.0.2", Username: "jump", PrivateKeyFile: "/keys/10.0.0.2_jump"},
+					{Hostname: "10.0.0.3", Port: 2022, Username: "ops", PrivateKeyFile: "/keys/10.0.0.3_ops"},
+				},
+			},
+		},
+		{
+			name: "manifest values are kept",
+			opts: Opts{
+				Hostname:  "node.internal",
+				Username:  "ubuntu",
+				Port:      2022,
+				KeyFile:   "/manifest/key",
+				JumpHosts: []JumpHostOpts{{Hostname: "10.0.0.9", Port: 22, Username: "jumper", PrivateKeyFile: "/manifest/jump"}},
+			},
+			want: Opts{
+				Hostname:  "node.internal",
+				Username:  "ubuntu",
+				Port:      2022,
+				KeyFile:   "/manifest/key",
+				JumpHosts: []JumpHostOpts{{Hostname: "10.0.0.9", Port: 22, Username: "jumper", PrivateKeyFile: "/manifest/jump"}},
+			},
+		},
+		{
+			name: "negated pattern and ProxyJump none",
+			opts: Opts{Hostname: "db.internal", Username: "root"},
+			want: Opts{Hostname: "db.internal", Username: "fallback", KeyFile: "/keys/db.internal_fallback"},
+		},
+		{
+			name: "bastion disables ProxyJump",
+			opts: Opts{Hostname: "node.internal", Username: "root", Bastion: "10.0.0.1"},
+			want: Opts{Hostname: "node.internal", Username: "admin", Port: 2200, KeyFile: "/keys/node.internal_admin", Bastion: "10.0.0.1"},
+		},
+		{
+			name: "host name is resolved",
+			opts: Opts{Hostname: "BASTION-1", Username: "root", AgentSocket: "env:SSH_AUTH_SOCK"},
+			want: Opts{Hostname: "bastion.example.com", Username: "fallback", Port: 2222, KeyFile: "/keys/bastion.example.com_fallback", AgentSocket: "env:SSH_AUTH_SOCK"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.Apply(tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseUserConfigErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{
+			name:   "missing argument",
+			config: "Host a\n  User\n",
+		},
+		{
+			name:   "invalid port",
+			config: "Host a\n  Port ssh\n",
+		},
+		{
+			name:   "invalid ProxyJump port",
+			config: "Host a\n  ProxyJump b:port\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &UserConfig{}
+			if err := cfg.parse(strings.NewReader(tt.config), 0); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -20,16 +20,20 @@ import (
 	"context"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/configupload"
+	"k8c.io/kubeone/pkg/events"
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/runner"
 	"k8c.io/kubeone/pkg/ssh"
 	"k8c.io/kubeone/pkg/templates/images"
+	"k8c.io/kubeone/pkg/tracing"
 
 	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
 	"k8s.io/client-go/rest"
@@ -118,6 +122,37 @@ type State struct {
 	CredentialsFilePath       string
 	ManifestFilePath          string
 	PauseImage                string
+	// Concurrency limits the number of nodes the parallel tasks run on at
+	// the same time, zero means no limit
+	Concurrency int
+	// Retry is the retry policy of the tasks, from the flags or the
+	// KubeOne config
+	Retry kubeoneapi.RetryPolicy
+	// Events is the stream of the task and node lifecycle events
+	Events *events.Stream
+	// TaskOperation is the operation of the task currently being run,
+	// reported in the node events
+	TaskOperation string
+	// SkipEtcdSnapshot disables the etcd snapshot taken before the
+	// disruptive operations
+	SkipEtcdSnapshot bool
+	// EtcdSnapshotRetention is the number of the automatic etcd snapshots
+	// kept next to the backup file, zero means the default
+	EtcdSnapshotRetention int
+	// UpgradeRecord is the state captured before the upgrade being rolled
+	// back
+	UpgradeRecord *UpgradeRecord
+	// RestoreEtcd restores etcd from the pre-upgrade snapshot when rolling
+	// back the upgrade
+	RestoreEtcd bool
+	// Journal records completed checkpointed tasks for the resumable apply
+	Journal *Journal
+	// JournalOperation is the checkpointed operation being currently run,
+	// nodes on which it has already been completed are skipped
+	JournalOperation string
+	// AbortContext is canceled on the forced cancellation (the second
+	// interrupt), unlike the Context which is detached in the critical tasks
+	AbortContext context.Context
 }
 
 func (s *State) KubeadmVerboseFlag() string {
@@ -131,6 +166,49 @@ func (s *State) Clone() *State {
 	return &newState
 }
 
+// StartSpan starts the tracing span as a child of the current one and makes
+// it current until the returned function ends it
+func (s *State) StartSpan(name string, attrs ...attribute.KeyValue) func(err error) {
+	parent := s.Context
+
+	ctx, span := tracing.Start(parent, name, attrs...)
+	s.Context = ctx
+
+	return func(err error) {
+		tracing.End(span, err)
+		s.Context = parent
+	}
+}
+
+// Interrupted returns the context error if the run has been interrupted
+func (s *State) Interrupted() error {
+	if s.Context == nil {
+		return nil
+	}
+
+	return s.Context.Err()
+}
+
+// Sleep pauses for the given duration, returning early with the context
+// error if the run gets interrupted
+func (s *State) Sleep(d time.Duration) error {
+	if s.Context == nil {
+		time.Sleep(d)
+
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-s.Context.Done():
+		return s.Context.Err()
+	}
+}
+
 func (s *State) ShouldDisableEncryption() bool {
 	return (s.Cluster.Features.EncryptionProviders == nil ||
 		!s.Cluster.Features.EncryptionProviders.Enable) &&
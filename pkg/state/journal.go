@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// Journal persistently records which checkpointed tasks, and on which nodes,
+// were completed, so that a failed run can be resumed without repeating them.
+// A nil *Journal is valid and records nothing.
+type Journal struct {
+	path string
+	lock sync.Mutex
+
+	// Fingerprint identifies the cluster configuration the journal is valid
+	// for
+	Fingerprint string         `json:"fingerprint"`
+	Completed   []JournalEntry `json:"completed,omitempty"`
+}
+
+// JournalEntry is a single completed operation. Node is empty if the
+// operation as a whole has been completed.
+type JournalEntry struct {
+	Operation string    `json:"operation"`
+	Node      string    `json:"node,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+// NewJournal creates an empty journal stored in the given path, overwriting
+// any journal left from the previous runs.
+func NewJournal(path, fingerprint string) (*Journal, error) {
+	j := &Journal{
+		path:        path,
+		Fingerprint: fingerprint,
+	}
+
+	return j, j.save()
+}
+
+// LoadJournal loads the journal from the given path and verifies that it
+// belongs to the cluster configuration with the given fingerprint.
+func LoadJournal(path, fingerprint string) (*Journal, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fail.NewConfigError("loading journal", "no journal found at %q, nothing to resume", path)
+		}
+
+		return nil, fail.Runtime(err, "reading journal")
+	}
+
+	j := &Journal{path: path}
+	if err = json.Unmarshal(buf, j); err != nil {
+		return nil, fail.Runtime(err, "unmarshalling journal")
+	}
+
+	if j.Fingerprint != fingerprint {
+		return nil, fail.NewConfigError("loading journal", "journal %q was recorded for a different cluster configuration", path)
+	}
+
+	return j, nil
+}
+
+// JournalPath returns the path of the journal stored next to the backup
+// file.
+func JournalPath(backupFile, clusterName string) string {
+	return filepath.Join(filepath.Dir(backupFile), clusterName+"-journal.json")
+}
+
+// Done returns whether the operation has been completed on the given node,
+// or as a whole if node is empty.
+func (j *Journal) Done(operation, node string) bool {
+	if j == nil || operation == "" {
+		return false
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for _, entry := range j.Completed {
+		if entry.Operation == operation && entry.Node == node {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Record marks the operation as completed on the given node, or as a whole if
+// node is empty, and persists the journal.
+func (j *Journal) Record(operation, node string) error {
+	if j == nil || operation == "" {
+		return nil
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	j.Completed = append(j.Completed, JournalEntry{
+		Operation: operation,
+		Node:      node,
+		Time:      time.Now().UTC(),
+	})
+
+	return j.save()
+}
+
+// Remove deletes the journal after the successful run.
+func (j *Journal) Remove() error {
+	if j == nil {
+		return nil
+	}
+
+	err := os.Remove(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return fail.Runtime(err, "removing journal")
+}
+
+func (j *Journal) save() error {
+	buf, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return fail.Runtime(err, "marshalling journal")
+	}
+
+	return fail.Runtime(writeFileAtomic(j.path, buf, 0o600), "writing journal")
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+)
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test-journal.json")
+
+	journal, err := NewJournal(path, "fingerprint")
+	if err != nil {
+		t.Fatalf("NewJournal() error = %v", err)
+	}
+
+	if err = journal.Record("installing prerequisites", "192.168.1.1"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	if err = journal.Record("pre-pull images", ""); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	loaded, err := LoadJournal(path, "fingerprint")
+	if err != nil {
+		t.Fatalf("LoadJournal() error = %v", err)
+	}
+
+	tests := []struct {
+		operation string
+		node      string
+		want      bool
+	}{
+		{operation: "installing prerequisites", node: "192.168.1.1", want: true},
+		{operation: "installing prerequisites", node: "192.168.1.2", want: false},
+		{operation: "installing prerequisites", node: "", want: false},
+		{operation: "pre-pull images", node: "", want: true},
+		{operation: "", node: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := loaded.Done(tt.operation, tt.node); got != tt.want {
+			t.Errorf("Done(%q, %q) = %v, want %v", tt.operation, tt.node, got, tt.want)
+		}
+	}
+
+	if _, err = LoadJournal(path, "other-fingerprint"); err == nil {
+		t.Errorf("LoadJournal() with different fingerprint succeeded, want error")
+	}
+
+	if err = loaded.Remove(); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+
+	if _, err = os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("journal file still exists after Remove(), stat error = %v", err)
+	}
+}
+
+var errTestRefused = errors.New("refused")
+
+type refusingExecutor struct {
+	opened []string
+}
+
+func (e *refusingExecutor) Open(host kubeoneapi.HostConfig) (executor.Interface, error) {
+	e.opened = append(e.opened, host.PublicAddress)
+
+	return nil, errTestRefused
+}
+
+func (e *refusingExecutor) Tunnel(_ kubeoneapi.HostConfig) (executor.Tunneler, error) {
+	return nil, errTestRefused
+}
+
+func TestRunTaskOnNodesSkipsJournaledNodes(t *testing.T) {
+	journal, err := NewJournal(filepath.Join(t.TempDir(), "test-journal.json"), "fingerprint")
+	if err != nil {
+		t.Fatalf("NewJournal() error = %v", err)
+	}
+
+	if err = journal.Record("upgrading kubelets", "192.168.1.1"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	exec := &refusingExecutor{}
+	s := &State{
+		Logger:           logger,
+		Executor:         exec,
+		Journal:          journal,
+		JournalOperation: "upgrading kubelets",
+	}
+
+	nodes := []kubeoneapi.HostConfig{
+		{PublicAddress: "192.168.1.1"},
+		{PublicAddress: "192.168.1.2"},
+	}
+
+	noop := func(*State, *kubeoneapi.HostConfig, executor.Interface) error { return nil }
+	if err = s.RunTaskOnNodes(nodes, noop, RunSequentially, nil); !errors.Is(err, errTestRefused) {
+		t.Fatalf("RunTaskOnNodes() error = %v, want %v", err, errTestRefused)
+	}
+
+	if len(exec.opened) != 1 || exec.opened[0] != "192.168.1.2" {
+		t.Errorf("RunTaskOnNodes() connected to %v, want [192.168.1.2]", exec.opened)
+	}
+}
+
+func TestRunTaskOnNodesRebuildsMutatedState(t *testing.T) {
+	journal, err := NewJournal(filepath.Join(t.TempDir(), "test-journal.json"), "fingerprint")
+	if err != nil {
+		t.Fatalf("NewJournal() error = %v", err)
+	}
+
+	// journaled by the previous run
+	if err = journal.Record("determining the pause image", "192.168.1.1"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	s := &State{
+		Logger:           logger,
+		Executor:         nopExecutor{},
+		Journal:          journal,
+		JournalOperation: "determining the pause image",
+	}
+
+	nodes := []kubeoneapi.HostConfig{
+		{PublicAddress: "192.168.1.1"},
+	}
+
+	task := func(s *State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		s.PauseImage = "registry.k8s.io/pause:3.10"
+
+		return nil
+	}
+	mutator := func(original, tmp *State) {
+		original.PauseImage = tmp.PauseImage
+	}
+
+	for run := 1; run <= 2; run++ {
+		s.PauseImage = ""
+
+		if err = s.RunTaskOnNodes(nodes, task, RunSequentially, mutator); err != nil {
+			t.Fatalf("RunTaskOnNodes() error = %v", err)
+		}
+
+		if s.PauseImage == "" {
+			t.Errorf("run %d: the state of the resumed task wasn't rebuilt", run)
+		}
+	}
+}
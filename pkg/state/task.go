@@ -17,13 +17,18 @@ limitations under the License.
 package state
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/events"
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/runner"
+	"k8c.io/kubeone/pkg/tracing"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
@@ -31,7 +36,43 @@ import (
 // NodeTask is a task that is specifically tailored to run on a single node.
 type NodeTask func(ctx *State, node *kubeoneapi.HostConfig, conn executor.Interface) error
 
+// Critical wraps the node task that must not be interrupted half-way, e.g.
+// because it cordons the node and uncordons it at the end. Interrupting the
+// run doesn't cancel the context of the critical task, the run stops after
+// the task is finished on the current node.
+func Critical(task NodeTask) NodeTask {
+	return func(s *State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		if s.Context != nil {
+			s.Context = context.WithoutCancel(s.Context)
+		}
+
+		return task(s, node, conn)
+	}
+}
+
 func (s *State) runTask(node *kubeoneapi.HostConfig, task NodeTask) error {
+	nodeEvent := events.Event{
+		Task: s.TaskOperation,
+		Node: node.PublicAddress,
+	}
+
+	s.Events.Emit(nodeEvent.WithType(events.NodeStarted))
+
+	start := time.Now()
+	endSpan := s.StartSpan("node "+node.PublicAddress, tracing.NodeKey.String(node.PublicAddress))
+	err := s.runNodeTask(node, task)
+	endSpan(err)
+
+	if err != nil {
+		s.Events.Emit(nodeEvent.WithType(events.NodeFailed).WithDuration(start).WithError(err))
+	} else {
+		s.Events.Emit(nodeEvent.WithType(events.NodeSucceeded).WithDuration(start))
+	}
+
+	return err
+}
+
+func (s *State) runNodeTask(node *kubeoneapi.HostConfig, task NodeTask) error {
 	var (
 		err  error
 		conn executor.Interface
@@ -39,47 +80,109 @@ func (s *State) runTask(node *kubeoneapi.HostConfig, task NodeTask) error {
 
 	// connect to the host (and do not close connection
 	// because we want to re-use it for future tasks)
-	conn, err = s.Executor.Open(*node)
+	conn, err = executor.Open(s.Context, s.Executor, *node)
 	if err != nil {
 		return err
 	}
 
 	s.Runner = &runner.Runner{
+		Context:  s.Context,
 		Executor: conn,
 		Verbose:  s.Verbose,
 		OS:       node.OperatingSystem,
 		Prefix:   fmt.Sprintf("[%s] ", node.PublicAddress),
 	}
 
-	return fail.Runtime(task(s, node, conn), "")
+	// tasks started from within the node task are not journaled on their own
+	journalOperation := s.JournalOperation
+	s.JournalOperation = ""
+
+	if err = task(s, node, conn); err != nil {
+		return fail.Runtime(err, "")
+	}
+
+	return s.Journal.Record(journalOperation, node.PublicAddress)
 }
 
 type stateMutatorFn func(original, tmp *State)
 
-// RunTaskOnNodes runs the given task on the given selection of hosts.
+// RunTaskOnNodes runs the given task on the given selection of hosts. In the
+// parallel mode, at most s.Concurrency nodes are worked on at the same time
+// and the task is run on all nodes even if it fails on some of them. The
+// tasks with the state mutator are not journaled, they're run on all nodes
+// again on resume to rebuild the state the subsequent tasks use.
 func (s *State) RunTaskOnNodes(nodes []kubeoneapi.HostConfig, task NodeTask, parallel RunModeEnum, stateMutator stateMutatorFn) error {
 	var (
 		stateMutatorLock sync.Mutex
 		errorsLock       sync.Mutex
 		aggregateErrs    []error
+		failedNodes      []string
+		journalOperation = s.JournalOperation
 	)
 
+	if stateMutator != nil {
+		journalOperation = ""
+	}
+
 	wg := sync.WaitGroup{}
 
+	// workers limits the number of nodes the task is running on at the same
+	// time, it's unlimited if nil
+	var workers chan struct{}
+	if parallel == RunParallel && s.Concurrency > 0 && s.Concurrency < len(nodes) {
+		workers = make(chan struct{}, s.Concurrency)
+	}
+
 	for i := range nodes {
 		ctx := s.Clone()
 		ctx.Logger = ctx.Logger.WithField("node", nodes[i].PublicAddress)
+		ctx.JournalOperation = journalOperation
+
+		if workers != nil {
+			workers <- struct{}{}
+		}
+
+		if err := s.Interrupted(); err != nil {
+			errorsLock.Lock()
+			aggregateErrs = append(aggregateErrs, fail.Runtime(err, "running task on %q", nodes[i].PublicAddress))
+			errorsLock.Unlock()
+
+			break
+		}
+
+		if s.Journal.Done(journalOperation, nodes[i].PublicAddress) {
+			ctx.Logger.Infof("Skipping %s, already completed in the previous run", journalOperation)
+			s.Events.Emit(events.Event{
+				Type:   events.NodeSkipped,
+				Task:   s.TaskOperation,
+				Node:   nodes[i].PublicAddress,
+				Reason: "completed in the previous run",
+			})
+
+			if workers != nil {
+				<-workers
+			}
+
+			continue
+		}
 
 		if parallel == RunParallel {
 			wg.Add(1)
 			go func(ctx *State, node *kubeoneapi.HostConfig) {
+				defer wg.Done()
+
+				if workers != nil {
+					defer func() { <-workers }()
+				}
+
 				err := ctx.runTask(node, task)
 				if err != nil {
 					ctx.Logger.Error(err)
 
 					errorsLock.Lock()
-					defer errorsLock.Unlock()
 					aggregateErrs = append(aggregateErrs, fail.Runtime(err, "running task on %q", node.PublicAddress))
+					failedNodes = append(failedNodes, node.PublicAddress)
+					errorsLock.Unlock()
 				}
 
 				if stateMutator != nil {
@@ -87,8 +190,6 @@ func (s *State) RunTaskOnNodes(nodes []kubeoneapi.HostConfig, task NodeTask, par
 					stateMutator(s, ctx)
 					stateMutatorLock.Unlock()
 				}
-
-				wg.Done()
 			}(ctx, &nodes[i])
 		} else {
 			err := ctx.runTask(&nodes[i], task)
@@ -106,6 +207,10 @@ func (s *State) RunTaskOnNodes(nodes []kubeoneapi.HostConfig, task NodeTask, par
 
 	wg.Wait()
 
+	if len(failedNodes) > 0 && len(nodes) > 1 {
+		s.Logger.Errorf("Task failed on %d of %d nodes: %s", len(failedNodes), len(nodes), strings.Join(failedNodes, ", "))
+	}
+
 	return utilerrors.NewAggregate(aggregateErrs)
 }
 
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+)
+
+func TestRunTaskOnNodesStopsWhenInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	exec := &refusingExecutor{}
+	s := &State{
+		Context:  ctx,
+		Logger:   logger,
+		Executor: exec,
+	}
+
+	nodes := []kubeoneapi.HostConfig{
+		{PublicAddress: "192.168.1.1"},
+		{PublicAddress: "192.168.1.2"},
+	}
+
+	noop := func(*State, *kubeoneapi.HostConfig, executor.Interface) error { return nil }
+	if err := s.RunTaskOnNodes(nodes, noop, RunParallel, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("RunTaskOnNodes() error = %v, want %v", err, context.Canceled)
+	}
+
+	if len(exec.opened) != 0 {
+		t.Errorf("RunTaskOnNodes() connected to %v after the interrupt", exec.opened)
+	}
+}
+
+type nopExecutor struct{}
+
+func (nopExecutor) Open(kubeoneapi.HostConfig) (executor.Interface, error) {
+	return nil, nil
+}
+
+func (nopExecutor) Tunnel(kubeoneapi.HostConfig) (executor.Tunneler, error) {
+	return nil, nil
+}
+
+func TestRunTaskOnNodesConcurrency(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	s := &State{
+		Logger:      logger,
+		Executor:    nopExecutor{},
+		Concurrency: 3,
+	}
+
+	nodes := make([]kubeoneapi.HostConfig, 10)
+
+	var running, maxRunning, done atomic.Int32
+	task := func(*State, *kubeoneapi.HostConfig, executor.Interface) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			current := maxRunning.Load()
+			if n <= current || maxRunning.CompareAndSwap(current, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		done.Add(1)
+
+		return nil
+	}
+
+	if err := s.RunTaskOnNodes(nodes, task, RunParallel, nil); err != nil {
+		t.Fatalf("RunTaskOnNodes() error = %v", err)
+	}
+
+	if got := done.Load(); got != int32(len(nodes)) {
+		t.Errorf("task was run on %d nodes, want %d", got, len(nodes))
+	}
+
+	if got := maxRunning.Load(); got > int32(s.Concurrency) {
+		t.Errorf("task was running on %d nodes at the same time, want at most %d", got, s.Concurrency)
+	}
+}
+
+func TestSleepInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := &State{Context: ctx}
+	if err := s.Sleep(time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("Sleep() error = %v, want %v", err, context.Canceled)
+	}
+
+	critical := Critical(func(s *State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		return s.Interrupted()
+	})
+	if err := critical(s, &kubeoneapi.HostConfig{}, nil); err != nil {
+		t.Errorf("Interrupted() in critical task = %v, want nil", err)
+	}
+}
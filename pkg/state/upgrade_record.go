@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// UpgradeRecord is the state of the cluster captured before the upgrade, used
+// to roll the upgrade back. The static pod manifests and the kubelet
+// configuration are backed up on the nodes themselves.
+type UpgradeRecord struct {
+	// KubernetesVersion is the version the cluster ran before the upgrade
+	KubernetesVersion string `json:"kubernetesVersion"`
+	// TargetKubernetesVersion is the version the cluster was upgraded to
+	TargetKubernetesVersion string `json:"targetKubernetesVersion"`
+	// EtcdSnapshot is the path of the etcd snapshot taken before the
+	// upgrade, if any
+	EtcdSnapshot string `json:"etcdSnapshot,omitempty"`
+	// ConfigMaps are the data of the kubeadm ConfigMaps in kube-system,
+	// keyed by their name
+	ConfigMaps map[string]map[string]string `json:"configMaps,omitempty"`
+	Time       time.Time                    `json:"time"`
+}
+
+// UpgradeRecordPath returns the path of the upgrade record stored next to
+// the backup file.
+func UpgradeRecordPath(backupFile, clusterName string) string {
+	return filepath.Join(filepath.Dir(backupFile), clusterName+"-upgrade-record.json")
+}
+
+// LoadUpgradeRecord loads the upgrade record from the given path.
+func LoadUpgradeRecord(path string) (*UpgradeRecord, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fail.NewConfigError("loading upgrade record", "no upgrade record found at %q, nothing to roll back", path)
+		}
+
+		return nil, fail.Runtime(err, "reading upgrade record")
+	}
+
+	r := &UpgradeRecord{}
+	if err = json.Unmarshal(buf, r); err != nil {
+		return nil, fail.Runtime(err, "unmarshalling upgrade record")
+	}
+
+	return r, nil
+}
+
+// Save writes the upgrade record to the given path.
+func (r *UpgradeRecord) Save(path string) error {
+	buf, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fail.Runtime(err, "marshalling upgrade record")
+	}
+
+	return fail.Runtime(writeFileAtomic(path, buf, 0o600), "writing upgrade record")
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpgradeRecord(t *testing.T) {
+	path := UpgradeRecordPath(filepath.Join(t.TempDir(), "test.tar.gz"), "test")
+
+	if _, err := LoadUpgradeRecord(path); err == nil {
+		t.Fatalf("LoadUpgradeRecord() without the record succeeded, want error")
+	}
+
+	record := &UpgradeRecord{
+		KubernetesVersion:       "1.33.4",
+		TargetKubernetesVersion: "1.34.1",
+		EtcdSnapshot:            "/backups/test-etcd-snapshot-20260101T100000Z.db",
+		ConfigMaps: map[string]map[string]string{
+			"kubeadm-config": {"ClusterConfiguration": "kubernetesVersion: v1.33.4\n"},
+		},
+		Time: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	if err := record.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := LoadUpgradeRecord(path)
+	if err != nil {
+		t.Fatalf("LoadUpgradeRecord() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded, record) {
+		t.Errorf("LoadUpgradeRecord() = %+v, want %+v", loaded, record)
+	}
+}
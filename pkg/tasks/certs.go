@@ -208,9 +208,8 @@ func restartKubelet(s *state.State, node *kubeoneapi.HostConfig, _ executor.Inte
 	// which should be enough.
 	sleepTime := 40 * time.Second
 	s.Logger.Infof("Waiting %s to give Kubelet time to regenerate CSRs...", sleepTime)
-	time.Sleep(sleepTime)
 
-	return nil
+	return s.Sleep(sleepTime)
 }
 
 func restartKubeletOnControlPlane(s *state.State) error {
@@ -226,7 +225,9 @@ func ApprovePendingCSR(s *state.State, node *kubeoneapi.HostConfig, _ executor.I
 	var csrFound bool
 	sleepTime := 20 * time.Second
 	s.Logger.Infof("Waiting %s for CSRs to approve...", sleepTime)
-	time.Sleep(sleepTime)
+	if err := s.Sleep(sleepTime); err != nil {
+		return err
+	}
 
 	csrList := certificatesv1.CertificateSigningRequestList{}
 	if err := s.DynamicClient.List(s.Context, &csrList); err != nil {
@@ -37,7 +37,9 @@ func joinControlPlaneNodeInternal(s *state.State, node *kubeoneapi.HostConfig, c
 
 	sleepTime := 15 * time.Second
 	logger.Infof("Waiting %s to ensure main control plane components are up...", sleepTime)
-	time.Sleep(sleepTime)
+	if err := s.Sleep(sleepTime); err != nil {
+		return err
+	}
 
 	logger.Info("Joining control plane node")
 	cmd, err := scripts.KubeadmJoin(s.WorkDir, node.ID, s.KubeadmVerboseFlag())
@@ -50,6 +52,10 @@ func joinControlPlaneNodeInternal(s *state.State, node *kubeoneapi.HostConfig, c
 		return fail.SSH(err, "joining control plane node %q", node.PublicAddress)
 	}
 
+	if err := runHooks(s, s.Cluster.Hooks.AfterJoin, hookAfterJoin, node); err != nil {
+		return err
+	}
+
 	return ApprovePendingCSR(s, node, conn)
 }
 
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"k8c.io/kubeone/pkg/etcdutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+)
+
+const (
+	// defaultEtcdSnapshotRetention is the number of the automatic etcd
+	// snapshots kept next to the backup file, unless configured otherwise
+	defaultEtcdSnapshotRetention = 3
+
+	etcdSnapshotTaskName = "etcd-snapshot"
+)
+
+// withEtcdSnapshot appends the task taking the etcd snapshot before the
+// disruptive operation, unless it's already in the list, so the combined
+// operations, e.g. disabling encryption providers and upgrading, take it once.
+func withEtcdSnapshot(t Tasks) Tasks {
+	for _, task := range t {
+		if task.Name == etcdSnapshotTaskName {
+			return t
+		}
+	}
+
+	return t.append(Task{
+		Fn:          saveEtcdSnapshot,
+		Name:        etcdSnapshotTaskName,
+		Operation:   "snapshotting etcd",
+		Description: "save etcd snapshot next to the backup file",
+		Predicate:   func(s *state.State) bool { return !s.SkipEtcdSnapshot },
+		Checkpoint:  true,
+		Phase:       PhaseEtcdSnapshot,
+	})
+}
+
+// saveEtcdSnapshot saves the snapshot of the etcd cluster next to the PKI
+// backup file and deletes the oldest snapshots over the retention count
+func saveEtcdSnapshot(s *state.State) error {
+	dir := filepath.Dir(s.BackupFile)
+	name := etcdSnapshotPrefix(s.Cluster.Name) + time.Now().UTC().Format("20060102T150405Z") + ".db"
+	path := filepath.Join(dir, name)
+
+	s.Logger.Infof("Saving etcd snapshot to %q...", path)
+
+	// the snapshot is written to the temporary file first, so the partial
+	// snapshot is never mistaken for the complete one
+	tmp, err := os.CreateTemp(dir, name+".*.part")
+	if err != nil {
+		return fail.Runtime(err, "creating etcd snapshot file")
+	}
+	defer os.Remove(tmp.Name())
+
+	version, err := etcdutil.Snapshot(s, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = fail.Runtime(closeErr, "closing etcd snapshot file")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fail.Runtime(err, "saving etcd snapshot to %q", path)
+	}
+
+	s.Logger.Infof("Etcd snapshot saved (etcd version: %s)", version)
+
+	retention := s.EtcdSnapshotRetention
+	if retention <= 0 {
+		retention = defaultEtcdSnapshotRetention
+	}
+
+	return pruneEtcdSnapshots(s, dir, retention)
+}
+
+// pruneEtcdSnapshots deletes the automatic etcd snapshots of the cluster in
+// the directory, except for the given number of the newest ones
+func pruneEtcdSnapshots(s *state.State, dir string, retention int) error {
+	snapshots, err := listEtcdSnapshots(dir, s.Cluster.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) <= retention {
+		return nil
+	}
+
+	for _, snapshot := range snapshots[:len(snapshots)-retention] {
+		s.Logger.Infof("Deleting old etcd snapshot %q...", snapshot)
+		if err := os.Remove(snapshot); err != nil {
+			return fail.Runtime(err, "deleting old etcd snapshot %q", snapshot)
+		}
+	}
+
+	return nil
+}
+
+// listEtcdSnapshots returns the automatic etcd snapshots of the cluster in
+// the directory, from the oldest to the newest
+func listEtcdSnapshots(dir, clusterName string) ([]string, error) {
+	snapshots, err := filepath.Glob(filepath.Join(dir, etcdSnapshotPrefix(clusterName)+"*.db"))
+	if err != nil {
+		return nil, fail.Runtime(err, "listing etcd snapshots")
+	}
+
+	// the timestamps in the names sort chronologically
+	slices.Sort(snapshots)
+
+	return snapshots, nil
+}
+
+func etcdSnapshotPrefix(clusterName string) string {
+	return clusterName + "-etcd-snapshot-"
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+func TestWithEtcdSnapshot(t *testing.T) {
+	tasks := withEtcdSnapshot(withEtcdSnapshot(Tasks{{Name: "probes"}}))
+
+	count := 0
+	for _, task := range tasks {
+		if task.Name == etcdSnapshotTaskName {
+			count++
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("withEtcdSnapshot() added %d snapshot tasks, want 1", count)
+	}
+}
+
+func TestPruneEtcdSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{
+		"test-etcd-snapshot-20260101T100000Z.db",
+		"test-etcd-snapshot-20260102T100000Z.db",
+		"test-etcd-snapshot-20260103T100000Z.db",
+		"test-etcd-snapshot-20260104T100000Z.db",
+		"other-etcd-snapshot-20260101T100000Z.db",
+		"test.tar.gz",
+	}
+
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(dir, file), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := newSchedulerTestState()
+	s.Cluster = &kubeoneapi.KubeOneCluster{Name: "test"}
+
+	if err := pruneEtcdSnapshots(s, dir, 2); err != nil {
+		t.Fatalf("pruneEtcdSnapshots() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Name())
+	}
+
+	want := []string{
+		"other-etcd-snapshot-20260101T100000Z.db",
+		"test-etcd-snapshot-20260103T100000Z.db",
+		"test-etcd-snapshot-20260104T100000Z.db",
+		"test.tar.gz",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("pruneEtcdSnapshots() left %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// hook points, as passed to the hooks in KUBEONE_HOOK
+const (
+	hookBeforeDrain  = "beforeDrain"
+	hookAfterUpgrade = "afterUpgrade"
+	hookAfterJoin    = "afterJoin"
+	hookBeforeReset  = "beforeReset"
+)
+
+// runHooks runs the hooks of the given point for the node, in order. Remote
+// hooks are run over the already open connection to the node, local hooks on
+// the machine running KubeOne. The failed hook aborts the operation without
+// retrying, unless its failure policy is Ignore.
+func runHooks(s *state.State, hooks []kubeoneapi.Hook, point string, node *kubeoneapi.HostConfig) error {
+	logger := s.Logger.WithField("node", node.PublicAddress)
+
+	for _, hook := range hooks {
+		name := hook.Name
+		if name == "" {
+			name = hook.Command
+		}
+
+		where := "node"
+		if hook.Local {
+			where = "locally"
+		}
+
+		logger.Infof("Running %s hook %q %s...", point, name, where)
+
+		script := hookScript(point, s.Cluster.Name, node, hook.Command)
+
+		var err error
+		if hook.Local {
+			err = runLocalHook(s, node, script)
+		} else {
+			_, _, err = s.Runner.RunRaw(script)
+		}
+
+		if err == nil {
+			continue
+		}
+
+		if hook.FailurePolicy == kubeoneapi.HookFailurePolicyIgnore {
+			logger.Warnf("Ignoring failed %s hook %q: %s", point, name, err)
+
+			continue
+		}
+
+		return fail.NonRetryable(fail.Runtime(err, "running %s hook %q on %s", point, name, node.PublicAddress))
+	}
+
+	return nil
+}
+
+func runLocalHook(s *state.State, node *kubeoneapi.HostConfig, script string) error {
+	// the hooks run in the critical tasks, whose context is never canceled,
+	// so the process is killed only when the run is aborted
+	ctx := s.AbortContext
+	if ctx == nil {
+		ctx = s.Context
+	}
+
+	// the relative commands are resolved against the manifest directory,
+	// like the other paths in the manifest
+	if s.ManifestFilePath != "" {
+		manifestDir, err := filepath.Abs(filepath.Dir(s.ManifestFilePath))
+		if err != nil {
+			return fail.Runtime(err, "getting absolute path to the cluster manifest")
+		}
+		script = fmt.Sprintf("cd %s || exit 1\n%s", shellQuote(manifestDir), script)
+	}
+
+	conn, err := executor.NewLocal(ctx).Open(*node)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, _, _, err = conn.Exec(script)
+
+	return err
+}
+
+// hookScript prepends the hook command with the exported variables describing
+// the hook point and the node
+func hookScript(point, clusterName string, node *kubeoneapi.HostConfig, command string) string {
+	env := []struct {
+		name, value string
+	}{
+		{"KUBEONE_HOOK", point},
+		{"KUBEONE_CLUSTER_NAME", clusterName},
+		{"KUBEONE_NODE_HOSTNAME", node.Hostname},
+		{"KUBEONE_NODE_PUBLIC_ADDRESS", node.PublicAddress},
+		{"KUBEONE_NODE_PRIVATE_ADDRESS", node.PrivateAddress},
+	}
+
+	var script strings.Builder
+	for _, e := range env {
+		fmt.Fprintf(&script, "export %s=%s\n", e.name, shellQuote(e.value))
+	}
+	script.WriteString(command)
+
+	return script.String()
+}
+
+// shellQuote quotes the value for bash, so it's never expanded
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'"'"'`) + "'"
+}
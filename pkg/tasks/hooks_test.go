@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+func TestHookScript(t *testing.T) {
+	node := &kubeoneapi.HostConfig{
+		Hostname:       "cp-0",
+		PublicAddress:  "192.0.2.10",
+		PrivateAddress: "10.0.0.10",
+	}
+
+	got := hookScript(hookBeforeDrain, "it's-a-cluster", node, "./notify.sh")
+	want := `export KUBEONE_HOOK='beforeDrain'
+export KUBEONE_CLUSTER_NAME='it'"'"'s-a-cluster'
+export KUBEONE_NODE_HOSTNAME='cp-0'
+export KUBEONE_NODE_PUBLIC_ADDRESS='192.0.2.10'
+export KUBEONE_NODE_PRIVATE_ADDRESS='10.0.0.10'
+./notify.sh`
+
+	if got != want {
+		t.Errorf("hookScript() = %q, want %q", got, want)
+	}
+}
+
+func TestRunHooksLocal(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	node := &kubeoneapi.HostConfig{Hostname: "worker-0", PublicAddress: "192.0.2.20"}
+
+	tests := []struct {
+		name       string
+		hooks      []kubeoneapi.Hook
+		wantErr    bool
+		wantOutput string
+	}{
+		{
+			name: "hooks run in order",
+			hooks: []kubeoneapi.Hook{
+				{Command: `echo -n "$KUBEONE_HOOK " >> ` + out, Local: true},
+				{Command: `echo -n "$KUBEONE_NODE_HOSTNAME" >> ` + out, Local: true},
+			},
+			wantOutput: "afterJoin worker-0",
+		},
+		{
+			name: "failed hook is ignored",
+			hooks: []kubeoneapi.Hook{
+				{Command: "exit 1", Local: true, FailurePolicy: kubeoneapi.HookFailurePolicyIgnore},
+				{Command: "echo -n done >> " + out, Local: true},
+			},
+			wantOutput: "done",
+		},
+		{
+			name: "failed hook aborts",
+			hooks: []kubeoneapi.Hook{
+				{Command: "exit 1", Local: true},
+				{Command: "echo -n done >> " + out, Local: true},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Remove(out)
+
+			s := newSchedulerTestState()
+			s.Cluster = &kubeoneapi.KubeOneCluster{Name: "test"}
+
+			err := runHooks(s, tt.hooks, hookAfterJoin, node)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runHooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && fail.Retryable(err) {
+				t.Errorf("runHooks() error = %v, want not retryable", err)
+			}
+
+			got, _ := os.ReadFile(out)
+			if string(got) != tt.wantOutput {
+				t.Errorf("hooks output = %q, want %q", got, tt.wantOutput)
+			}
+		})
+	}
+}
+
+func TestRunHooksLocalManifestDir(t *testing.T) {
+	manifestDir := t.TempDir()
+	script := "#!/usr/bin/env bash\necho -n \"$KUBEONE_HOOK\" > notify.out\n"
+	if err := os.MkdirAll(filepath.Join(manifestDir, "hooks"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(manifestDir, "hooks", "notify.sh"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// the hook must not depend on the working directory of KubeOne
+	t.Chdir(t.TempDir())
+
+	s := newSchedulerTestState()
+	s.Cluster = &kubeoneapi.KubeOneCluster{Name: "test"}
+	s.ManifestFilePath = filepath.Join(manifestDir, "kubeone.yaml")
+
+	hooks := []kubeoneapi.Hook{{Command: "./hooks/notify.sh", Local: true}}
+	node := &kubeoneapi.HostConfig{Hostname: "worker-0", PublicAddress: "192.0.2.20"}
+
+	if err := runHooks(s, hooks, hookAfterUpgrade, node); err != nil {
+		t.Fatalf("runHooks() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(manifestDir, "notify.out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != hookAfterUpgrade {
+		t.Errorf("hook output = %q, want %q", got, hookAfterUpgrade)
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/clusterstatus/apiserverstatus"
+	"k8c.io/kubeone/pkg/clusterstatus/etcdstatus"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultNodeHealthCheckTimeout is how long kubeone waits for the
+	// upgraded node to become healthy, unless configured otherwise
+	defaultNodeHealthCheckTimeout = 5 * time.Minute
+	nodeHealthCheckInterval       = 5 * time.Second
+)
+
+// controlPlaneStaticPods are the static pods which must be healthy on the
+// upgraded control plane node
+var controlPlaneStaticPods = []string{
+	"etcd",
+	"kube-apiserver",
+	"kube-controller-manager",
+	"kube-scheduler",
+}
+
+// waitForNodeHealthy waits for the upgraded node to become healthy. Once the
+// kubelet is upgraded, it must also run the target version. The error is
+// returned if the node doesn't recover before the timeout, and it's not
+// retried, halting the upgrade.
+func waitForNodeHealthy(s *state.State, node *kubeoneapi.HostConfig, kubeletUpgraded bool) error {
+	logger := s.Logger.WithField("node", node.PublicAddress)
+
+	config := s.Cluster.Execution.NodeHealthCheck
+	timeout := config.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultNodeHealthCheckTimeout
+	}
+
+	selectors := make([]labels.Selector, 0, len(config.PodSelectors))
+	for _, selector := range config.PodSelectors {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return fail.Config(err, "parsing node health check pod selector")
+		}
+		selectors = append(selectors, parsed)
+	}
+
+	kubeletVersion := ""
+	if kubeletUpgraded {
+		kubeletVersion = s.Cluster.Versions.Kubernetes
+	}
+
+	controlPlane := isControlPlaneHost(s.Cluster, node)
+
+	logger.Infof("Waiting up to %v for the node to become healthy...", timeout)
+
+	var unhealthy error
+	err := wait.PollUntilContextTimeout(s.Context, nodeHealthCheckInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		unhealthy = checkNodeHealth(ctx, s, node, kubeletVersion, controlPlane, selectors)
+		if unhealthy != nil {
+			logger.Debugf("Node is not healthy yet: %s", unhealthy)
+
+			return false, nil
+		}
+
+		return true, nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	if s.Interrupted() != nil || unhealthy == nil {
+		return err
+	}
+
+	return fail.NonRetryable(fail.RuntimeError{
+		Op:  fmt.Sprintf("waiting for the node %s to become healthy", node.Hostname),
+		Err: errors.Wrapf(unhealthy, "node didn't recover in %v", timeout),
+	})
+}
+
+// checkNodeHealth returns the reason why the node isn't healthy, or nil if it
+// is healthy
+func checkNodeHealth(ctx context.Context, s *state.State, node *kubeoneapi.HostConfig, kubeletVersion string, controlPlane bool, selectors []labels.Selector) error {
+	k8sNode := corev1.Node{}
+	if err := s.DynamicClient.Get(ctx, types.NamespacedName{Name: node.Hostname}, &k8sNode); err != nil {
+		return fail.KubeClient(err, "getting node %s", node.Hostname)
+	}
+
+	if err := nodeReadyWithKubelet(&k8sNode, kubeletVersion); err != nil {
+		return err
+	}
+
+	if controlPlane {
+		if err := controlPlaneHealthy(ctx, s, node); err != nil {
+			return err
+		}
+	}
+
+	for _, selector := range selectors {
+		pods := corev1.PodList{}
+		if err := s.DynamicClient.List(ctx, &pods, &dynclient.ListOptions{LabelSelector: selector}); err != nil {
+			return fail.KubeClient(err, "listing pods %q", selector)
+		}
+
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName != node.Hostname {
+				continue
+			}
+
+			if !podRunningAndReady(&pod) {
+				return errors.Errorf("pod %s/%s is not ready", pod.Namespace, pod.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// nodeReadyWithKubelet checks that the node is Ready and runs the kubelet of
+// the given version, if any
+func nodeReadyWithKubelet(node *corev1.Node, kubeletVersion string) error {
+	ready := false
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady && cond.Status == corev1.ConditionTrue {
+			ready = true
+		}
+	}
+
+	if !ready {
+		return errors.Errorf("node %s is not Ready", node.Name)
+	}
+
+	if kubeletVersion == "" {
+		return nil
+	}
+
+	want, err := semver.NewVersion(kubeletVersion)
+	if err != nil {
+		return fail.Config(err, "parsing the kubernetes version")
+	}
+
+	got, err := semver.NewVersion(node.Status.NodeInfo.KubeletVersion)
+	if err != nil {
+		return errors.Wrapf(err, "parsing the kubelet version of the node %s", node.Name)
+	}
+
+	if !got.Equal(want) {
+		return errors.Errorf("node %s runs kubelet %s, want %s", node.Name, got, want)
+	}
+
+	return nil
+}
+
+// controlPlaneHealthy checks the static pods, kube-apiserver and etcd of the
+// control plane node
+func controlPlaneHealthy(ctx context.Context, s *state.State, node *kubeoneapi.HostConfig) error {
+	for _, component := range controlPlaneStaticPods {
+		pod := corev1.Pod{}
+		key := types.NamespacedName{
+			Namespace: metav1.NamespaceSystem,
+			Name:      fmt.Sprintf("%s-%s", component, node.Hostname),
+		}
+
+		if err := s.DynamicClient.Get(ctx, key, &pod); err != nil {
+			return fail.KubeClient(err, "getting %s pod", key)
+		}
+
+		if !podRunningAndReady(&pod) {
+			return errors.Errorf("pod %s is not ready", key)
+		}
+	}
+
+	apiserver, err := apiserverstatus.Get(s, *node)
+	if err != nil {
+		return err
+	}
+
+	if !apiserver.Health {
+		return errors.New("kube-apiserver is not healthy")
+	}
+
+	etcdRing, err := etcdstatus.MemberList(s)
+	if err != nil {
+		return err
+	}
+
+	etcd, err := etcdstatus.Get(s, *node, etcdRing)
+	if err != nil {
+		return err
+	}
+
+	if !etcd.Health || !etcd.Member {
+		return errors.New("etcd member is not healthy")
+	}
+
+	return nil
+}
+
+func podRunningAndReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func isControlPlaneHost(cluster *kubeoneapi.KubeOneCluster, node *kubeoneapi.HostConfig) bool {
+	for _, host := range cluster.ControlPlane.Hosts {
+		if host.PrivateAddress == node.PrivateAddress {
+			return true
+		}
+	}
+
+	return false
+}
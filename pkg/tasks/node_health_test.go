@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_nodeReadyWithKubelet(t *testing.T) {
+	node := func(ready corev1.ConditionStatus, kubeletVersion string) *corev1.Node {
+		return &corev1.Node{
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: ready},
+				},
+				NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubeletVersion},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		node           *corev1.Node
+		kubeletVersion string
+		wantErr        bool
+	}{
+		{
+			name:           "ready with the target kubelet",
+			node:           node(corev1.ConditionTrue, "v1.33.1"),
+			kubeletVersion: "1.33.1",
+		},
+		{
+			name: "ready without checking the kubelet",
+			node: node(corev1.ConditionTrue, "v1.32.4"),
+		},
+		{
+			name:           "not ready",
+			node:           node(corev1.ConditionFalse, "v1.33.1"),
+			kubeletVersion: "1.33.1",
+			wantErr:        true,
+		},
+		{
+			name:    "no ready condition",
+			node:    &corev1.Node{},
+			wantErr: true,
+		},
+		{
+			name:           "old kubelet",
+			node:           node(corev1.ConditionTrue, "v1.32.4"),
+			kubeletVersion: "1.33.1",
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := nodeReadyWithKubelet(tt.node, tt.kubeletVersion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("nodeReadyWithKubelet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_podRunningAndReady(t *testing.T) {
+	tests := []struct {
+		name   string
+		status corev1.PodStatus
+		want   bool
+	}{
+		{
+			name: "running and ready",
+			status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+			want: true,
+		},
+		{
+			name: "running but not ready",
+			status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+			},
+			want: false,
+		},
+		{
+			name: "pending",
+			status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podRunningAndReady(&corev1.Pod{Status: tt.status}); got != tt.want {
+				t.Errorf("podRunningAndReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
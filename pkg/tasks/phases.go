@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"slices"
+	"strings"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// Phases group the tasks which can be selected or skipped together. Tasks
+// without the phase populate the State used by the other tasks (detecting
+// hostnames and OS, probes, safeguards, building the clientset, etc.), so
+// they are always run.
+const (
+	PhasePrerequisites      = "prerequisites"
+	PhaseKubernetesConfig   = "kubernetes-config"
+	PhaseEtcdSnapshot       = "etcd-snapshot"
+	PhaseControlPlane       = "control-plane"
+	PhaseCertificates       = "certificates"
+	PhaseKubeconfig         = "kubeconfig"
+	PhaseFeatures           = "features"
+	PhaseCredentials        = "credentials"
+	PhaseNodeLabels         = "node-labels"
+	PhaseAddons             = "addons"
+	PhaseHelmReleases       = "helm-releases"
+	PhaseExternalCCM        = "external-ccm"
+	PhaseStaticWorkers      = "static-workers"
+	PhaseMachineDeployments = "machine-deployments"
+	PhaseKubelets           = "kubelets"
+	PhaseEncryption         = "encryption"
+	PhaseCleanup            = "cleanup"
+)
+
+// Phases lists all phases in the order they are run
+var Phases = []string{
+	PhasePrerequisites,
+	PhaseKubernetesConfig,
+	PhaseEtcdSnapshot,
+	PhaseControlPlane,
+	PhaseCertificates,
+	PhaseKubeconfig,
+	PhaseFeatures,
+	PhaseCredentials,
+	PhaseNodeLabels,
+	PhaseAddons,
+	PhaseHelmReleases,
+	PhaseExternalCCM,
+	PhaseStaticWorkers,
+	PhaseMachineDeployments,
+	PhaseKubelets,
+	PhaseEncryption,
+	PhaseCleanup,
+}
+
+// phaseRequires lists the phases selected together with the phase by
+// PhaseFilter.Only, because the phase can't be run safely without them.
+// Kubeadm running on the nodes needs the up-to-date configuration files.
+var phaseRequires = map[string][]string{
+	PhaseControlPlane:  {PhaseKubernetesConfig},
+	PhaseCertificates:  {PhaseKubernetesConfig},
+	PhaseStaticWorkers: {PhaseKubernetesConfig},
+	PhaseKubelets:      {PhaseKubernetesConfig},
+}
+
+// PhaseFilter selects the phases of the tasks to run
+type PhaseFilter struct {
+	// Only lists the phases to run, all phases are run if empty
+	Only []string
+	// Skip lists the phases not to run, it takes precedence over Only
+	Skip []string
+}
+
+// Empty returns true if the filter selects all phases
+func (f PhaseFilter) Empty() bool {
+	return len(f.Only) == 0 && len(f.Skip) == 0
+}
+
+// Validate returns an error if the filter refers to an unknown phase
+func (f PhaseFilter) Validate() error {
+	for _, phase := range append(slices.Clone(f.Only), f.Skip...) {
+		if !slices.Contains(Phases, phase) {
+			return fail.NewConfigError("selecting phases", "unknown phase %q, known phases are: %s", phase, strings.Join(Phases, ", "))
+		}
+	}
+
+	return nil
+}
+
+// Selected returns true if the tasks of the phase are run. Tasks without the
+// phase are always run.
+func (f PhaseFilter) Selected(phase string) bool {
+	if phase == "" {
+		return true
+	}
+
+	if slices.Contains(f.Skip, phase) {
+		return false
+	}
+
+	if len(f.Only) == 0 || slices.Contains(f.Only, phase) {
+		return true
+	}
+
+	for _, selected := range f.Only {
+		if slices.Contains(phaseRequires[selected], phase) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithPhases returns the tasks of the phases selected by the filter. The
+// names of the filtered out tasks are removed from DependsOn of the
+// remaining tasks.
+func (t Tasks) WithPhases(filter PhaseFilter) (Tasks, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if filter.Empty() {
+		return t, nil
+	}
+
+	var (
+		selected Tasks
+		dropped  []string
+	)
+
+	for _, task := range t {
+		if filter.Selected(task.Phase) {
+			selected = append(selected, task)
+		} else if task.Name != "" {
+			dropped = append(dropped, task.Name)
+		}
+	}
+
+	for i, task := range selected {
+		if len(task.DependsOn) == 0 {
+			continue
+		}
+
+		selected[i].DependsOn = slices.DeleteFunc(slices.Clone(task.DependsOn), func(name string) bool {
+			return slices.Contains(dropped, name)
+		})
+	}
+
+	return selected, nil
+}
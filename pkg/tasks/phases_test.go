@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTasksWithPhases(t *testing.T) {
+	t.Parallel()
+
+	all := Tasks{
+		{Operation: "probes"},
+		{Operation: "config", Phase: PhaseKubernetesConfig},
+		{Operation: "join", Name: "join", Phase: PhaseStaticWorkers},
+		{Operation: "label", Name: "label", Phase: PhaseNodeLabels, DependsOn: []string{"join"}},
+		{Operation: "addons", Name: "addons", Phase: PhaseAddons},
+		{Operation: "helm", Phase: PhaseHelmReleases, DependsOn: []string{"addons"}},
+	}
+
+	tests := []struct {
+		name        string
+		filter      PhaseFilter
+		wantOps     []string
+		wantDepends map[string][]string
+		wantErr     bool
+	}{
+		{
+			name:    "no filter",
+			wantOps: []string{"probes", "config", "join", "label", "addons", "helm"},
+		},
+		{
+			name:        "only",
+			filter:      PhaseFilter{Only: []string{PhaseHelmReleases, PhaseNodeLabels}},
+			wantOps:     []string{"probes", "label", "helm"},
+			wantDepends: map[string][]string{"label": {}, "helm": {}},
+		},
+		{
+			name:        "only with required phases",
+			filter:      PhaseFilter{Only: []string{PhaseStaticWorkers, PhaseNodeLabels}},
+			wantOps:     []string{"probes", "config", "join", "label"},
+			wantDepends: map[string][]string{"label": {"join"}},
+		},
+		{
+			name:    "skip",
+			filter:  PhaseFilter{Skip: []string{PhaseKubernetesConfig, PhaseAddons, PhaseHelmReleases}},
+			wantOps: []string{"probes", "join", "label"},
+		},
+		{
+			name:    "skip takes precedence",
+			filter:  PhaseFilter{Only: []string{PhaseStaticWorkers}, Skip: []string{PhaseKubernetesConfig}},
+			wantOps: []string{"probes", "join"},
+		},
+		{
+			name:    "unknown phase",
+			filter:  PhaseFilter{Skip: []string{"foo"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := all.WithPhases(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WithPhases() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var ops []string
+			for _, task := range got {
+				ops = append(ops, task.Operation)
+				if want, ok := tt.wantDepends[task.Operation]; ok && !reflect.DeepEqual(task.DependsOn, want) {
+					t.Errorf("WithPhases() %q depends on %v, want %v", task.Operation, task.DependsOn, want)
+				}
+			}
+
+			if !reflect.DeepEqual(ops, tt.wantOps) {
+				t.Errorf("WithPhases() = %v, want %v", ops, tt.wantOps)
+			}
+
+			if len(all[3].DependsOn) != 1 || len(all[5].DependsOn) != 1 {
+				t.Errorf("WithPhases() modified the original tasks")
+			}
+		})
+	}
+}
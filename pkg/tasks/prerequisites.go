@@ -194,7 +194,9 @@ func disableNMCloudSetup(s *state.State, node *kubeoneapi.HostConfig, _ executor
 
 			timeout := 1 * time.Minute
 			s.Logger.Infof("Waiting for %s before proceeding to give machines time to boot up...", timeout)
-			time.Sleep(timeout)
+			if err := s.Sleep(timeout); err != nil {
+				return err
+			}
 
 			// NB: In some cases, KubeOne might not be able to re-use SSH connections
 			// after rebooting nodes. Because of that, we close all connections here,
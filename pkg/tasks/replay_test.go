@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"flag"
+	"slices"
+	"strings"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/executor/executortest"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+var updateFlag = flag.Bool("update", false, "update testdata files")
+
+// newReplayTestState returns the state of the cluster with two control plane
+// nodes and one static worker, connected with the adapter
+func newReplayTestState(adapter executor.Adapter) *state.State {
+	host := func(id int, address, hostname string) kubeoneapi.HostConfig {
+		return kubeoneapi.HostConfig{
+			ID:              id,
+			PublicAddress:   address,
+			PrivateAddress:  address,
+			Hostname:        hostname,
+			IsLeader:        id == 0,
+			OperatingSystem: kubeoneapi.OperatingSystemNameUbuntu,
+		}
+	}
+
+	s := newSchedulerTestState()
+	s.Executor = adapter
+	s.Cluster = &kubeoneapi.KubeOneCluster{
+		Name: "test",
+		ControlPlane: kubeoneapi.ControlPlaneConfig{
+			Hosts: []kubeoneapi.HostConfig{
+				host(0, "192.168.1.1", "control-plane-0"),
+				host(1, "192.168.1.2", "control-plane-1"),
+			},
+		},
+		StaticWorkers: kubeoneapi.StaticWorkersConfig{
+			Hosts: []kubeoneapi.HostConfig{
+				host(2, "192.168.1.3", "worker-0"),
+			},
+		},
+		Versions: kubeoneapi.VersionConfig{
+			Kubernetes: "1.33.1",
+		},
+		ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+			Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
+		},
+		SystemPackages: &kubeoneapi.SystemPackages{
+			ConfigureRepositories: true,
+		},
+	}
+
+	return s
+}
+
+// runFlow runs the tasks of the flow with the given operations in order, like
+// the scheduler does
+func runFlow(s *state.State, flow Tasks, operations ...string) error {
+	for _, task := range flow {
+		if !slices.Contains(operations, task.Operation) {
+			continue
+		}
+
+		if task.Predicate != nil && !task.Predicate(s) {
+			continue
+		}
+
+		if err := task.Fn(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadFlowFixture returns the session of the flow recorded in the testdata.
+// With the -update flag, the session is recorded again answering all
+// commands successfully.
+func loadFlowFixture(t *testing.T, newState func(executor.Adapter) *state.State, flow Tasks, operations ...string) executortest.Fixture {
+	t.Helper()
+
+	fixtureFile := testhelper.TestDataFSName(t, ".yaml")
+
+	if *updateFlag {
+		fake := executortest.NewFake().Handle(executortest.CommandMatches(""), executortest.Response{})
+		recorder := executortest.NewRecorder(fake)
+
+		if err := runFlow(newState(recorder), flow, operations...); err != nil {
+			t.Fatal(err)
+		}
+
+		// the nodes run in parallel, the commands of each one keep their order
+		fixture := recorder.Fixture()
+		slices.SortStableFunc(fixture.Commands, func(a, b executortest.RecordedCommand) int {
+			return strings.Compare(a.Host, b.Host)
+		})
+
+		if err := fixture.WriteFile(fixtureFile); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fixture, err := executortest.LoadFixture(fixtureFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fixture
+}
+
+func TestUpgradeFlowReplay(t *testing.T) {
+	flow := WithUpgrade(nil, nil, kubeoneapi.StaticWorkersConfig{})
+	operations := []string{
+		"restarting unhealthy kube-apiserver",
+		"deleting unused container images",
+		"cleanup /etc/kubernetes/tmp",
+	}
+
+	newState := func(adapter executor.Adapter) *state.State {
+		s := newReplayTestState(adapter)
+		s.PruneImages = true
+
+		return s
+	}
+
+	replayer := executortest.NewReplayer(loadFlowFixture(t, newState, flow, operations...))
+
+	if err := runFlow(newState(replayer), flow, operations...); err != nil {
+		t.Fatalf("upgrade error = %v", err)
+	}
+
+	if unused := replayer.Unused(); len(unused) != 0 {
+		t.Errorf("upgrade hasn't run the recorded commands %v", unused)
+	}
+}
+
+func TestRepairFlowReplay(t *testing.T) {
+	const failingHost = "192.168.1.2"
+
+	flow := WithRepair(nil)
+	operations := []string{
+		"disabling nm-cloud-setup",
+		"installing prerequisites",
+	}
+
+	fixture := loadFlowFixture(t, newReplayTestState, flow, operations...)
+
+	tests := []struct {
+		name        string
+		failingHost string
+		// wantUnused are the hosts whose commands aren't run
+		wantUnused []string
+	}{
+		{
+			name: "all nodes repaired",
+		},
+		{
+			name:        "static workers not repaired after control plane failure",
+			failingHost: failingHost,
+			wantUnused:  []string{"192.168.1.3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := slices.Clone(fixture.Commands)
+
+			// the last command of the host fails
+			for i := len(commands) - 1; i >= 0; i-- {
+				if commands[i].Host == tt.failingHost {
+					commands[i].ExitCode = 1
+
+					break
+				}
+			}
+
+			replayer := executortest.NewReplayer(executortest.Fixture{Commands: commands})
+
+			err := runFlow(newReplayTestState(replayer), flow, operations...)
+			if (err != nil) != (tt.failingHost != "") {
+				t.Fatalf("repair error = %v, want the error %t", err, tt.failingHost != "")
+			}
+
+			var unusedHosts []string
+			for _, cmd := range replayer.Unused() {
+				if !slices.Contains(unusedHosts, cmd.Host) {
+					unusedHosts = append(unusedHosts, cmd.Host)
+				}
+			}
+
+			if !slices.Equal(unusedHosts, tt.wantUnused) {
+				t.Errorf("repair hasn't run the recorded commands of %v, want %v", unusedHosts, tt.wantUnused)
+			}
+		})
+	}
+}
@@ -165,6 +165,10 @@ func resetAllNodes(s *state.State) error {
 }
 
 func resetNode(s *state.State, host *kubeoneapi.HostConfig, _ executor.Interface) error {
+	if err := runHooks(s, s.Cluster.Hooks.BeforeReset, hookBeforeReset, host); err != nil {
+		return err
+	}
+
 	s.Logger.Infoln("Resetting node...")
 
 	cmd, err := scripts.KubeadmReset(s.KubeadmVerboseFlag(), s.WorkDir)
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/nodeutils"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// upgradeRecordConfigMaps are the ConfigMaps in kube-system written by
+// kubeadm upgrade, which are restored when rolling the upgrade back
+var upgradeRecordConfigMaps = []string{
+	"kubeadm-config",
+	"kubelet-config",
+}
+
+// recordPreUpgradeState saves the upgrade record next to the backup file and
+// backs up the static pod manifests and the kubelet configuration on all
+// nodes, so the upgrade can be rolled back with `kubeone rollback`
+func recordPreUpgradeState(s *state.State) error {
+	path := state.UpgradeRecordPath(s.BackupFile, s.Cluster.Name)
+
+	// the previous failed attempt of the same upgrade has already recorded the
+	// state from before it started, which must not be overwritten with the
+	// partially upgraded one
+	if previous, err := state.LoadUpgradeRecord(path); err == nil && previous.TargetKubernetesVersion == s.Cluster.Versions.Kubernetes {
+		s.Logger.Infof("Keeping the pre-upgrade record %q of the previous attempt", path)
+
+		return nil
+	}
+
+	version, err := liveKubernetesVersion(s.LiveCluster)
+	if err != nil {
+		return err
+	}
+
+	record := &state.UpgradeRecord{
+		KubernetesVersion:       version,
+		TargetKubernetesVersion: s.Cluster.Versions.Kubernetes,
+		ConfigMaps:              map[string]map[string]string{},
+		Time:                    time.Now().UTC(),
+	}
+
+	if !s.SkipEtcdSnapshot {
+		snapshots, snapErr := listEtcdSnapshots(filepath.Dir(s.BackupFile), s.Cluster.Name)
+		if snapErr != nil {
+			return snapErr
+		}
+		if len(snapshots) > 0 {
+			record.EtcdSnapshot = snapshots[len(snapshots)-1]
+		}
+	}
+
+	for _, name := range upgradeRecordConfigMaps {
+		cm := corev1.ConfigMap{}
+		key := types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: name}
+
+		if err = s.DynamicClient.Get(s.Context, key, &cm); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return fail.KubeClient(err, "getting %s", key)
+		}
+
+		record.ConfigMaps[name] = cm.Data
+	}
+
+	s.Logger.Infoln("Backing up static pod manifests and kubelet configuration...")
+	if err = s.RunTaskOnAllNodes(backupNodeForRollback, state.RunParallel); err != nil {
+		return err
+	}
+
+	s.Logger.Infof("Saving pre-upgrade record to %q...", path)
+
+	return record.Save(path)
+}
+
+func backupNodeForRollback(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+	cmd, err := scripts.RollbackBackup()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
+
+	return fail.SSH(err, "backing up node for rollback")
+}
+
+// liveKubernetesVersion returns the lowest kubelet version of the control
+// plane nodes
+func liveKubernetesVersion(live *state.Cluster) (string, error) {
+	var lowest *semver.Version
+
+	if live != nil {
+		for _, host := range live.ControlPlane {
+			version := host.Kubelet.Version
+			if version != nil && (lowest == nil || version.LessThan(lowest)) {
+				lowest = version
+			}
+		}
+	}
+
+	if lowest == nil {
+		return "", fail.NewRuntimeError("recording pre-upgrade state", "unable to determine the current kubernetes version")
+	}
+
+	return lowest.String(), nil
+}
+
+// restoreEtcdFromSnapshot restores all etcd members from the snapshot taken
+// before the upgrade. The control plane static pods are stopped on all nodes
+// before any member is restored, and started again from the manifests
+// backed up before the upgrade.
+func restoreEtcdFromSnapshot(s *state.State) error {
+	snapshot := s.UpgradeRecord.EtcdSnapshot
+	if snapshot == "" {
+		return fail.NewConfigError("restoring etcd", "no etcd snapshot was taken before the upgrade")
+	}
+
+	if _, err := os.Stat(snapshot); err != nil {
+		return fail.Runtime(err, "checking etcd snapshot %q", snapshot)
+	}
+
+	var initialCluster []string
+	for _, host := range s.Cluster.ControlPlane.Hosts {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s=%s", host.Hostname, etcdPeerURL(s.Cluster, host)))
+	}
+
+	s.Logger.Infof("Uploading etcd snapshot %q...", snapshot)
+	if err := s.RunTaskOnControlPlane(uploadEtcdSnapshot(snapshot), state.RunParallel); err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Stopping control plane...")
+	if err := s.RunTaskOnControlPlane(stopControlPlaneForRollback, state.RunParallel); err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Restoring etcd members...")
+
+	return s.RunTaskOnControlPlane(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.RollbackRestoreEtcd(node.Hostname, etcdPeerURL(s.Cluster, *node), strings.Join(initialCluster, ","))
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "restoring etcd member %s", node.Hostname)
+	}, state.RunParallel)
+}
+
+func uploadEtcdSnapshot(snapshot string) state.NodeTask {
+	return func(_ *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		f, err := os.Open(snapshot)
+		if err != nil {
+			return fail.Runtime(err, "opening etcd snapshot %q", snapshot)
+		}
+		defer f.Close()
+
+		var stderr strings.Builder
+		cmd := fmt.Sprintf("sudo mkdir -p %s && sudo tee %s >/dev/null", scripts.RollbackDir, scripts.RollbackEtcdSnapshot)
+
+		if _, err = conn.POpen(cmd, f, io.Discard, &stderr); err != nil {
+			return fail.SSHError{
+				Op:     fmt.Sprintf("uploading etcd snapshot to %s", node.PublicAddress),
+				Err:    err,
+				Cmd:    cmd,
+				Stderr: stderr.String(),
+			}
+		}
+
+		return nil
+	}
+}
+
+func stopControlPlaneForRollback(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+	cmd, err := scripts.RollbackStopControlPlane()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
+
+	return fail.SSH(err, "stopping control plane")
+}
+
+func etcdPeerURL(cluster *kubeoneapi.KubeOneCluster, host kubeoneapi.HostConfig) string {
+	address := host.PrivateAddress
+	if cluster.ClusterNetwork.IPFamily.IsIPv6Primary() && len(host.IPv6Addresses) > 0 {
+		address = host.IPv6Addresses[0]
+	}
+
+	return "https://" + net.JoinHostPort(address, "2380")
+}
+
+func rollbackControlPlane(s *state.State) error {
+	return s.RunTaskOnControlPlane(state.Critical(rollbackNodeExecutor(true)), state.RunSequentially)
+}
+
+func rollbackStaticWorkers(s *state.State) error {
+	return s.RunTaskOnStaticWorkers(state.Critical(rollbackNodeExecutor(false)), state.RunSequentially)
+}
+
+// rollbackNodeExecutor downgrades the Kubernetes binaries of the node to the
+// version from before the upgrade and restores the files backed up before
+// the upgrade
+func rollbackNodeExecutor(controlPlane bool) state.NodeTask {
+	return func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		logger := s.Logger.WithField("node", node.PublicAddress)
+
+		drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
+
+		logger.Infoln("Cordoning node...")
+		if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
+			return err
+		}
+
+		logger.Infoln("Draining node...")
+		if err := drainer.Drain(s.Context, node.Hostname); err != nil {
+			return err
+		}
+
+		logger.Infof("Downgrading Kubernetes binaries to %s...", s.Cluster.Versions.Kubernetes)
+		params := scripts.Params{Force: true, Upgrade: true, Kubeadm: true, Kubectl: true, Kubelet: true}
+		if err := setupKubernetesBinaries(s, *node, params); err != nil {
+			return err
+		}
+
+		logger.Infoln("Restoring pre-upgrade configuration...")
+		cmd, err := scripts.RollbackRestore(controlPlane, s.RestoreEtcd)
+		if err != nil {
+			return err
+		}
+
+		if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+			return fail.SSH(err, "restoring pre-upgrade configuration")
+		}
+
+		logger.Infoln("Uncordoning node...")
+		if err = drainer.Cordon(s.Context, node.Hostname, false); err != nil {
+			return err
+		}
+
+		return waitForNodeHealthy(s, node, true)
+	}
+}
+
+// restoreUpgradeRecordConfigMaps restores the kubeadm ConfigMaps recorded
+// before the upgrade
+func restoreUpgradeRecordConfigMaps(s *state.State) error {
+	for name, data := range s.UpgradeRecord.ConfigMaps {
+		cm := corev1.ConfigMap{}
+		key := types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: name}
+
+		if err := s.DynamicClient.Get(s.Context, key, &cm); err != nil {
+			return fail.KubeClient(err, "getting %s", key)
+		}
+
+		cm.Data = data
+
+		if err := s.DynamicClient.Update(s.Context, &cm); err != nil {
+			return fail.KubeClient(err, "updating %s", key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/state"
+)
+
+func TestLiveKubernetesVersion(t *testing.T) {
+	host := func(version string) state.Host {
+		h := state.Host{}
+		if version != "" {
+			h.Kubelet.Version = semver.MustParse(version)
+		}
+
+		return h
+	}
+
+	tests := []struct {
+		name    string
+		live    *state.Cluster
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "lowest version",
+			live: &state.Cluster{
+				ControlPlane: []state.Host{host("1.34.1"), host("1.33.4"), host("")},
+			},
+			want: "1.33.4",
+		},
+		{
+			name:    "no versions",
+			live:    &state.Cluster{ControlPlane: []state.Host{host("")}},
+			wantErr: true,
+		},
+		{
+			name:    "not probed",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := liveKubernetesVersion(tt.live)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("liveKubernetesVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("liveKubernetesVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEtcdPeerURL(t *testing.T) {
+	host := kubeoneapi.HostConfig{
+		PrivateAddress: "10.0.0.1",
+		IPv6Addresses:  []string{"fd00::1"},
+	}
+
+	tests := []struct {
+		name     string
+		ipFamily kubeoneapi.IPFamily
+		want     string
+	}{
+		{name: "IPv4", ipFamily: kubeoneapi.IPFamilyIPv4, want: "https://10.0.0.1:2380"},
+		{name: "IPv6", ipFamily: kubeoneapi.IPFamilyIPv6, want: "https://[fd00::1]:2380"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &kubeoneapi.KubeOneCluster{
+				ClusterNetwork: kubeoneapi.ClusterNetworkConfig{IPFamily: tt.ipFamily},
+			}
+
+			if got := etcdPeerURL(cluster, host); got != tt.want {
+				t.Errorf("etcdPeerURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+)
+
+type taskStatus int
+
+const (
+	taskPending taskStatus = iota
+	taskRunning
+	taskDone
+)
+
+type taskResult struct {
+	index int
+	err   error
+}
+
+// dependencies returns indexes of the tasks each task depends on. A task
+// without DependsOn depends on all preceding tasks. A task with DependsOn
+// depends on the listed tasks and the nearest preceding task without
+// DependsOn.
+func (t Tasks) dependencies() ([][]int, error) {
+	deps := make([][]int, len(t))
+	barrier := -1
+
+	for i, step := range t {
+		if len(step.DependsOn) == 0 {
+			for j := range i {
+				deps[i] = append(deps[i], j)
+			}
+			barrier = i
+
+			continue
+		}
+
+		if barrier >= 0 {
+			deps[i] = append(deps[i], barrier)
+		}
+
+		for _, name := range step.DependsOn {
+			dep := t[:i].lastIndexOf(name)
+			if dep < 0 {
+				return nil, fail.NewRuntimeError("scheduling tasks", "task %q depends on %q which is not scheduled before it", step.name(), name)
+			}
+
+			if dep != barrier {
+				deps[i] = append(deps[i], dep)
+			}
+		}
+	}
+
+	return deps, nil
+}
+
+func (t Tasks) lastIndexOf(name string) int {
+	for i := len(t) - 1; i >= 0; i-- {
+		if t[i].Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// schedule runs the tasks as soon as all tasks they depend on are done.
+// Tasks without DependsOn run alone on the given state, the others run
+// concurrently on copies of the state. After the first failure no more tasks
+// are started, and the error is returned once the running tasks finish.
+func (t Tasks) schedule(s *state.State, deps [][]int) error {
+	status := make([]taskStatus, len(t))
+	results := make(chan taskResult)
+	running := 0
+
+	var firstErr error
+
+	ready := func(i int) bool {
+		if status[i] != taskPending {
+			return false
+		}
+
+		for _, dep := range deps[i] {
+			if status[dep] != taskDone {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for {
+		started := false
+
+		for i := 0; firstErr == nil && i < len(t); i++ {
+			if !ready(i) {
+				continue
+			}
+
+			if len(t[i].DependsOn) == 0 {
+				// all preceding tasks are done, so nothing else is running
+				status[i] = taskDone
+				firstErr = t[i].runStep(s)
+				started = true
+
+				break
+			}
+
+			status[i] = taskRunning
+			running++
+			started = true
+
+			go func(i int, s *state.State) {
+				results <- taskResult{index: i, err: t[i].runStep(s)}
+			}(i, s.Clone())
+		}
+
+		if started && firstErr == nil {
+			continue
+		}
+
+		if running == 0 {
+			return firstErr
+		}
+
+		result := <-results
+		running--
+		status[result.index] = taskDone
+
+		if result.err != nil && firstErr == nil {
+			firstErr = result.err
+		}
+	}
+}
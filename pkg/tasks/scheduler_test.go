@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/state"
+)
+
+func newSchedulerTestState() *state.State {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return &state.State{
+		Logger:  logger,
+		Context: context.Background(),
+	}
+}
+
+func TestTasksDependencies(t *testing.T) {
+	tests := []struct {
+		name    string
+		tasks   Tasks
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name:  "linear",
+			tasks: Tasks{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+			want:  [][]int{nil, {0}, {0, 1}},
+		},
+		{
+			name: "depends on the named task and the preceding barrier",
+			tasks: Tasks{
+				{Name: "a"},
+				{Name: "b"},
+				{Name: "c", DependsOn: []string{"b"}},
+				{Name: "d", DependsOn: []string{"b"}},
+				{Name: "e", DependsOn: []string{"c", "d"}},
+				{Name: "f"},
+			},
+			want: [][]int{nil, {0}, {1}, {1}, {1, 2, 3}, {0, 1, 2, 3, 4}},
+		},
+		{
+			name: "unknown dependency",
+			tasks: Tasks{
+				{Name: "a", DependsOn: []string{"b"}},
+				{Name: "b"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.tasks.dependencies()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dependencies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dependencies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskListsDependencies(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks Tasks
+	}{
+		{name: "full install", tasks: WithFullInstall(nil)},
+		{name: "repair", tasks: WithRepair(nil)},
+		{name: "upgrade", tasks: WithUpgrade(nil, nil, kubeoneapi.StaticWorkersConfig{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, err := tt.tasks.dependencies()
+			if err != nil {
+				t.Fatalf("dependencies() error = %v", err)
+			}
+
+			helm := slices.IndexFunc(tt.tasks, func(task Task) bool { return task.Operation == "releasing core helm charts" })
+			userAddons := tt.tasks.lastIndexOf("apply-user-addons")
+			if helm < 0 || userAddons < 0 {
+				t.Fatalf("helm releases task at %d, user addons task at %d", helm, userAddons)
+			}
+
+			if !slices.Contains(deps[helm], userAddons) {
+				t.Errorf("helm releases task depends on %v, want the user addons task %d", deps[helm], userAddons)
+			}
+		})
+	}
+}
+
+func TestTasksRunConcurrently(t *testing.T) {
+	var (
+		lock  sync.Mutex
+		order []string
+	)
+
+	record := func(name string) func(*state.State) error {
+		return func(*state.State) error {
+			lock.Lock()
+			defer lock.Unlock()
+
+			order = append(order, name)
+
+			return nil
+		}
+	}
+
+	// both dependents must be running at the same time to get released
+	release := sync.WaitGroup{}
+	release.Add(2)
+
+	waitForEachOther := func(name string) func(*state.State) error {
+		return func(s *state.State) error {
+			release.Done()
+			release.Wait()
+
+			return record(name)(s)
+		}
+	}
+
+	tasks := Tasks{
+		{Fn: record("first"), Operation: "first", Name: "first"},
+		{Fn: waitForEachOther("left"), Operation: "left", Name: "left", DependsOn: []string{"first"}},
+		{Fn: waitForEachOther("right"), Operation: "right", Name: "right", DependsOn: []string{"first"}},
+		{Fn: record("last"), Operation: "last"},
+	}
+
+	if err := tasks.Run(newSchedulerTestState()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if len(order) != 4 || order[0] != "first" || order[3] != "last" {
+		t.Errorf("unexpected order of tasks: %v", order)
+	}
+}
+
+func TestTasksRunStopsOnFailure(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	var ran []string
+
+	tasks := Tasks{
+		{
+			Fn:        func(*state.State) error { return errFailed },
+			Operation: "failing",
+			Name:      "failing",
+			Retries:   1,
+		},
+		{
+			Fn: func(*state.State) error {
+				ran = append(ran, "dependent")
+
+				return nil
+			},
+			Operation: "dependent",
+			DependsOn: []string{"failing"},
+		},
+		{
+			Fn: func(*state.State) error {
+				ran = append(ran, "last")
+
+				return nil
+			},
+			Operation: "last",
+		},
+	}
+
+	err := tasks.Run(newSchedulerTestState())
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Run() error = %v, want %v", err, errFailed)
+	}
+
+	if len(ran) != 0 {
+		t.Errorf("tasks after the failure should not run, but ran %v", ran)
+	}
+}
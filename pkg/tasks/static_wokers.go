@@ -42,5 +42,9 @@ func joinStaticWorkerInternal(s *state.State, node *kubeoneapi.HostConfig, conn
 		return fail.Runtime(err, "joining static worker %s", node.PublicAddress)
 	}
 
+	if err := runHooks(s, s.Cluster.Hooks.AfterJoin, hookAfterJoin, node); err != nil {
+		return err
+	}
+
 	return ApprovePendingCSR(s, node, conn)
 }
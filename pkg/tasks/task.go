@@ -20,12 +20,21 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
+	"k8c.io/kubeone/pkg/events"
+	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tracing"
 
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
-// defaultRetryBackoff is backoff with with duration of 5 seconds and factor of 2.0
+// defaultRetryAttempts is the number of attempts of the tasks without the
+// configured retry policy
+const defaultRetryAttempts = 10
+
+// defaultRetryBackoff is backoff with with duration of 10 seconds and factor of 1.4
 func defaultRetryBackoff(retries int) wait.Backoff {
 	return wait.Backoff{
 		Steps:    retries,
@@ -41,20 +50,82 @@ type Task struct {
 	Description string
 	Operation   string
 	Retries     int
+	// Checkpoint marks the task as safe to skip when resuming the failed
+	// run, once it's recorded as completed in the journal. Only tasks that
+	// don't populate the in-memory state used by the subsequent tasks can be
+	// checkpointed.
+	Checkpoint bool
+	// Name identifies the task for DependsOn of the subsequent tasks
+	Name string
+	// DependsOn lists names of the preceding tasks which must be completed
+	// before this task is started. Tasks with DependsOn are run concurrently
+	// with each other, on a copy of the State, as soon as their dependencies
+	// and the nearest preceding task without DependsOn are completed, so
+	// they must not modify the State for the subsequent tasks. Tasks without
+	// DependsOn wait for all preceding tasks and run alone.
+	DependsOn []string
+	// Phase groups the task with the related tasks, so they can be selected
+	// or skipped together using PhaseFilter. Tasks without Phase are always
+	// run.
+	Phase string
+}
+
+// journalKey identifies the task in the journal. Description is included
+// because some tasks share the same Operation, e.g. per-node upgrades.
+func (t *Task) journalKey() string {
+	if t.Description == "" {
+		return t.Operation
+	}
+
+	return t.Operation + ": " + t.Description
 }
 
-// Run runs a task
-func (t *Task) Run(s *state.State) error {
-	if t.Retries == 0 {
-		t.Retries = 10
+// Run runs a task, retrying it according to its retry policy
+func (t *Task) Run(s *state.State) (err error) {
+	backoff := t.retryBackoff(s)
+	attempts := backoff.Steps
+
+	taskEvent := events.Event{
+		Task:        t.Operation,
+		Description: t.Description,
 	}
 
-	backoff := defaultRetryBackoff(t.Retries)
+	operation := s.TaskOperation
+	s.TaskOperation = t.Operation
+	defer func() { s.TaskOperation = operation }()
+
+	endSpan := s.StartSpan(t.name(), tracing.TaskKey.String(t.Operation))
+	defer func() { endSpan(err) }()
+
+	start := time.Now()
+
+	defer func() {
+		if err != nil {
+			s.Events.Emit(taskEvent.WithType(events.TaskFailed).WithDuration(start).WithError(err))
+		} else {
+			s.Events.Emit(taskEvent.WithType(events.TaskSucceeded).WithDuration(start))
+		}
+	}()
 
 	var lastError error
-	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+	for {
+		// when interrupted before the first attempt, there is no lastError
+		// and the context error is returned
+		if err := s.Interrupted(); err != nil {
+			if lastError != nil {
+				return lastError
+			}
+
+			return err
+		}
+
+		taskEvent.Attempt++
+
 		if lastError != nil {
 			s.Logger.Warn("Retrying task...")
+			s.Events.Emit(taskEvent.WithType(events.TaskRetried).WithError(lastError))
+		} else {
+			s.Events.Emit(taskEvent.WithType(events.TaskStarted))
 		}
 
 		if t.Description != "" {
@@ -63,19 +134,80 @@ func (t *Task) Run(s *state.State) error {
 			s.Logger.Debugf("%s", t.Operation)
 		}
 
+		endAttempt := s.StartSpan("attempt", tracing.AttemptKey.Int(taskEvent.Attempt))
 		lastError = t.Fn(s)
-		if lastError != nil {
-			s.Logger.Warnf("Task %s failed, error was: %s", t.Operation, strings.ReplaceAll(lastError.Error(), "\\n", "\n"))
+		endAttempt(lastError)
 
-			return false, nil
+		if lastError == nil {
+			return nil
 		}
 
-		return true, nil
-	})
+		s.Logger.Warnf("Task %s failed, error was: %s", t.Operation, strings.ReplaceAll(lastError.Error(), "\\n", "\n"))
+
+		if !fail.Retryable(lastError) {
+			return errors.Wrapf(lastError, "failed on attempt %d of %d, not retrying", taskEvent.Attempt, attempts)
+		}
+
+		if taskEvent.Attempt >= attempts {
+			return errors.Wrapf(lastError, "failed on attempt %d of %d", taskEvent.Attempt, attempts)
+		}
+
+		if err := s.Sleep(backoff.Step()); err != nil {
+			return lastError
+		}
+	}
+}
+
+// retryBackoff returns the backoff of the task. The retry policy of the task's
+// operation in the KubeOne config takes precedence over Retries of the task,
+// which in turn takes precedence over the default retry policy.
+func (t *Task) retryBackoff(s *state.State) wait.Backoff {
+	policy := s.Retry
+
+	if t.Retries > 0 {
+		policy.Attempts = t.Retries
+	}
+
+	if s.Cluster != nil {
+		if override, ok := s.Cluster.Execution.OperationRetry[t.Operation]; ok {
+			if override.Attempts > 0 {
+				policy.Attempts = override.Attempts
+			}
+			if override.InitialBackOff.Duration > 0 {
+				policy.InitialBackOff = override.InitialBackOff
+			}
+			if override.MaxBackOff.Duration > 0 {
+				policy.MaxBackOff = override.MaxBackOff
+			}
+		}
+	}
+
+	backoff := defaultRetryBackoff(defaultRetryAttempts)
+	if policy.Attempts > 0 {
+		backoff.Steps = policy.Attempts
+	}
+	if policy.InitialBackOff.Duration > 0 {
+		backoff.Duration = policy.InitialBackOff.Duration
+	}
+	backoff.Cap = policy.MaxBackOff.Duration
+
+	return backoff
+}
+
+func (t *Task) skippedEvent(reason string) events.Event {
+	return events.Event{
+		Type:        events.TaskSkipped,
+		Task:        t.Operation,
+		Description: t.Description,
+		Reason:      reason,
+	}
+}
 
-	if wait.Interrupted(err) {
-		err = lastError
+// name returns the task name to use in the reports
+func (t *Task) name() string {
+	if t.Operation == "" {
+		return t.Description
 	}
 
-	return err
+	return t.Operation
 }
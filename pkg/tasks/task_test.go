@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTaskRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name         string
+		task         Task
+		retry        kubeoneapi.RetryPolicy
+		execution    kubeoneapi.ExecutionConfig
+		wantSteps    int
+		wantDuration time.Duration
+		wantCap      time.Duration
+	}{
+		{
+			name:         "default",
+			task:         Task{Operation: "op"},
+			wantSteps:    10,
+			wantDuration: 10 * time.Second,
+		},
+		{
+			name: "global policy",
+			task: Task{Operation: "op"},
+			retry: kubeoneapi.RetryPolicy{
+				Attempts:       3,
+				InitialBackOff: metav1.Duration{Duration: time.Second},
+				MaxBackOff:     metav1.Duration{Duration: time.Minute},
+			},
+			wantSteps:    3,
+			wantDuration: time.Second,
+			wantCap:      time.Minute,
+		},
+		{
+			name:         "task retries take precedence over the global policy",
+			task:         Task{Operation: "op", Retries: 1},
+			retry:        kubeoneapi.RetryPolicy{Attempts: 3},
+			wantSteps:    1,
+			wantDuration: 10 * time.Second,
+		},
+		{
+			name:  "operation policy takes precedence over the task retries",
+			task:  Task{Operation: "op", Retries: 1},
+			retry: kubeoneapi.RetryPolicy{Attempts: 3, InitialBackOff: metav1.Duration{Duration: time.Second}},
+			execution: kubeoneapi.ExecutionConfig{
+				OperationRetry: map[string]kubeoneapi.RetryPolicy{
+					"op":    {Attempts: 5},
+					"other": {Attempts: 7},
+				},
+			},
+			wantSteps:    5,
+			wantDuration: time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &state.State{
+				Retry:   tt.retry,
+				Cluster: &kubeoneapi.KubeOneCluster{Execution: tt.execution},
+			}
+
+			got := tt.task.retryBackoff(s)
+			if got.Steps != tt.wantSteps || got.Duration != tt.wantDuration || got.Cap != tt.wantCap {
+				t.Errorf("retryBackoff() = %+v, want steps %d, duration %s, cap %s", got, tt.wantSteps, tt.wantDuration, tt.wantCap)
+			}
+		})
+	}
+}
+
+func TestTaskRun(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name         string
+		errs         []error
+		wantAttempts int
+		wantErr      string
+	}{
+		{
+			name:         "succeeds after a retry",
+			errs:         []error{errFailed, nil},
+			wantAttempts: 2,
+		},
+		{
+			name:         "gives up after all attempts",
+			errs:         []error{errFailed, errFailed, errFailed},
+			wantAttempts: 3,
+			wantErr:      "failed on attempt 3 of 3",
+		},
+		{
+			name:         "doesn't retry config errors",
+			errs:         []error{fail.ConfigValidation(errFailed)},
+			wantAttempts: 1,
+			wantErr:      "failed on attempt 1 of 3, not retrying",
+		},
+		{
+			name:         "doesn't retry errors marked as non-retryable",
+			errs:         []error{fail.NonRetryable(errFailed)},
+			wantAttempts: 1,
+			wantErr:      "failed on attempt 1 of 3, not retrying",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSchedulerTestState()
+			s.Retry = kubeoneapi.RetryPolicy{
+				Attempts:       3,
+				InitialBackOff: metav1.Duration{Duration: time.Millisecond},
+			}
+
+			attempts := 0
+			task := Task{
+				Fn: func(*state.State) error {
+					err := tt.errs[attempts]
+					attempts++
+
+					return err
+				},
+				Operation: "op",
+			}
+
+			err := task.Run(s)
+			if attempts != tt.wantAttempts {
+				t.Errorf("Run() made %d attempts, want %d", attempts, tt.wantAttempts)
+			}
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("Run() error = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("Run() error = %v, want %q", err, tt.wantErr)
+			case tt.wantErr != "" && !errors.Is(err, errFailed):
+				t.Errorf("Run() error = %v, doesn't wrap %v", err, errFailed)
+			}
+		})
+	}
+}
@@ -17,8 +17,6 @@ limitations under the License.
 package tasks
 
 import (
-	"time"
-
 	"github.com/Masterminds/semver/v3"
 	"github.com/pkg/errors"
 
@@ -41,17 +39,64 @@ import (
 
 type Tasks []Task
 
-func (t Tasks) Run(s *state.State) error {
-	for _, step := range t {
-		if step.Predicate != nil && !step.Predicate(s) {
-			continue
+func (t Tasks) Run(s *state.State) (err error) {
+	endSpan := s.StartSpan("tasks")
+	defer func() { endSpan(err) }()
+
+	deps, err := t.dependencies()
+	if err != nil {
+		return err
+	}
+
+	return t.schedule(s, deps)
+}
+
+// runStep runs the task as a step of the task list, unless it's not needed or
+// has been completed in the previous run
+func (t *Task) runStep(s *state.State) error {
+	if t.Predicate != nil && !t.Predicate(s) {
+		s.Events.Emit(t.skippedEvent("not needed"))
+
+		return nil
+	}
+
+	if err := s.Interrupted(); err != nil {
+		return fail.InterruptedError{
+			Op:  t.name(),
+			Err: err,
+		}
+	}
+
+	if t.Checkpoint {
+		key := t.journalKey()
+		if s.Journal.Done(key, "") {
+			s.Logger.Infof("Skipping %s, already completed in the previous run", key)
+			s.Events.Emit(t.skippedEvent("completed in the previous run"))
+
+			return nil
 		}
-		if err := step.Run(s); err != nil {
-			return fail.RuntimeError{
-				Op:  step.Operation,
+
+		s.JournalOperation = key
+	}
+
+	err := t.Run(s)
+	s.JournalOperation = ""
+	if err != nil {
+		if s.Interrupted() != nil {
+			return fail.InterruptedError{
+				Op:  t.name(),
 				Err: errors.WithStack(err),
 			}
 		}
+
+		return fail.RuntimeError{
+			Op:  t.Operation,
+			Err: errors.WithStack(err),
+		}
+	}
+
+	if t.Checkpoint {
+		return s.Journal.Record(t.journalKey(), "")
 	}
 
 	return nil
@@ -86,7 +131,7 @@ func (t Tasks) prepend(newtasks ...Task) Tasks {
 func WithBinariesOnly(t Tasks) Tasks {
 	return WithHostnameOSAndProbes(t).
 		append(
-			Task{Fn: installPrerequisites, Operation: "installing prerequisites"},
+			Task{Fn: installPrerequisites, Operation: "installing prerequisites", Checkpoint: true, Phase: PhasePrerequisites},
 		)
 }
 
@@ -121,25 +166,48 @@ func WithHostnameOSAndProbes(t Tasks) Tasks {
 // WithFullInstall with install binaries (using WithBinariesOnly) and
 // orchestrate complete cluster init
 func WithFullInstall(t Tasks) Tasks {
-	return WithHostnameOSAndProbes(t).append(Tasks{
+	return withFullInstall(WithHostnameOSAndProbes(t))
+}
+
+// WithRepair is WithFullInstall of the already provisioned cluster, taking
+// the etcd snapshot before the missing nodes are installed and joined
+func WithRepair(t Tasks) Tasks {
+	return withFullInstall(withEtcdSnapshot(WithHostnameOSAndProbes(t)))
+}
+
+func withFullInstall(t Tasks) Tasks {
+	return t.append(Tasks{
 		{
 			Fn: func(s *state.State) error {
 				return s.RunTaskOnAllNodes(disableNMCloudSetup, state.RunParallel)
 			},
-			Operation: "disabling nm-cloud-setup",
+			Operation:  "disabling nm-cloud-setup",
+			Checkpoint: true,
+			Phase:      PhasePrerequisites,
 		},
 		{
-			Fn:        installPrerequisites,
-			Operation: "installing prerequisites",
+			Fn:         installPrerequisites,
+			Operation:  "installing prerequisites",
+			Checkpoint: true,
+			Phase:      PhasePrerequisites,
 		},
 	}...).
 		append(KubernetesConfigFiles()...).
 		append(Tasks{
 			{
-				Fn:        kubeadmPreflightChecks,
-				Operation: "kubeadm preflight checks",
+				Fn:         kubeadmPreflightChecks,
+				Operation:  "kubeadm preflight checks",
+				Checkpoint: true,
+				DependsOn:  []string{"upload-config-files"},
+				Phase:      PhasePrerequisites,
+			},
+			{
+				Fn:         prePullImages,
+				Operation:  "pre-pull images",
+				Checkpoint: true,
+				DependsOn:  []string{"upload-config-files"},
+				Phase:      PhasePrerequisites,
 			},
-			{Fn: prePullImages, Operation: "pre-pull images"},
 			{
 				Fn: func(s *state.State) error {
 					s.Logger.Infoln("Configuring certs and etcd on control plane node...")
@@ -147,6 +215,8 @@ func WithFullInstall(t Tasks) Tasks {
 					return s.RunTaskOnLeader(kubeadmCertsExecutor)
 				},
 				Operation: "provisioning certificates on the leader",
+				Name:      "leader-certificates",
+				Phase:     PhaseControlPlane,
 			},
 			{
 				Fn: func(s *state.State) error {
@@ -155,6 +225,8 @@ func WithFullInstall(t Tasks) Tasks {
 					return s.RunTaskOnLeader(certificate.DownloadKubePKI)
 				},
 				Operation: "downloading Kubernetes PKI from the leader",
+				Name:      "download-pki",
+				DependsOn: []string{"leader-certificates"},
 			},
 			{
 				Fn: func(s *state.State) error {
@@ -163,6 +235,9 @@ func WithFullInstall(t Tasks) Tasks {
 					return s.RunTaskOnFollowers(certificate.UploadKubePKI, state.RunParallel)
 				},
 				Operation: "uploading Kubernetes PKI",
+				Name:      "upload-pki",
+				DependsOn: []string{"download-pki"},
+				Phase:     PhaseControlPlane,
 			},
 			{
 				Fn: func(s *state.State) error {
@@ -171,18 +246,26 @@ func WithFullInstall(t Tasks) Tasks {
 					return s.RunTaskOnFollowers(kubeadmCertsExecutor, state.RunParallel)
 				},
 				Operation: "provisioning certificates on the followers",
+				DependsOn: []string{"upload-pki"},
+				Phase:     PhaseControlPlane,
+			},
+			{
+				Fn:        initKubernetesLeader,
+				Operation: "initializing kubernetes on leader",
+				DependsOn: []string{"leader-certificates"},
+				Phase:     PhaseControlPlane,
 			},
-			{Fn: initKubernetesLeader, Operation: "initializing kubernetes on leader"},
 			{Fn: kubeconfig.BuildKubernetesClientset, Operation: "building kubernetes clientset"},
 			{
 				Fn: func(s *state.State) error {
 					return s.RunTaskOnLeader(ApprovePendingCSR)
 				},
 				Operation: "approving leader's kubelet CSR",
+				Phase:     PhaseControlPlane,
 			},
-			{Fn: repairClusterIfNeeded, Operation: "repairing cluster"},
-			{Fn: joinControlplaneNode, Operation: "joining followers control plane nodes"},
-			{Fn: restartKubeAPIServer, Operation: "restarting unhealthy kube-apiserver"},
+			{Fn: repairClusterIfNeeded, Operation: "repairing cluster", Phase: PhaseControlPlane},
+			{Fn: joinControlplaneNode, Operation: "joining followers control plane nodes", Checkpoint: true, Phase: PhaseControlPlane},
+			{Fn: restartKubeAPIServer, Operation: "restarting unhealthy kube-apiserver", Phase: PhaseControlPlane},
 		}...).
 		append(WithResources(nil)...).
 		append(
@@ -208,13 +291,18 @@ func WithFullInstall(t Tasks) Tasks {
 				},
 				Operation: "removing old and approving new kubelet CSRs",
 				Predicate: func(s *state.State) bool { return s.Cluster.CloudProvider.External },
+				DependsOn: []string{"ensure-external-ccm"},
+				Phase:     PhaseExternalCCM,
 			},
 		).
 		append(
 			Task{
-				Fn:        createMachineDeployments,
-				Operation: "creating worker machines",
-				Predicate: func(s *state.State) bool { return !s.LiveCluster.IsProvisioned() },
+				Fn:         createMachineDeployments,
+				Operation:  "creating worker machines",
+				Predicate:  func(s *state.State) bool { return !s.LiveCluster.IsProvisioned() },
+				Checkpoint: true,
+				DependsOn:  []string{"wait-machine-controller", "wait-operating-system-manager"},
+				Phase:      PhaseMachineDeployments,
 			},
 		)
 }
@@ -235,22 +323,27 @@ func WithResources(t Tasks) Tasks {
 			{
 				Fn:        patchStaticPods,
 				Operation: "patching static pods",
+				Phase:     PhaseControlPlane,
 			},
 			{
 				Fn:          renewControlPlaneCerts,
 				Operation:   "renewing certificates",
 				Description: "renew all certificates",
+				Checkpoint:  true,
 				Predicate: func(s *state.State) bool {
 					return s.LiveCluster.CertsToExpireInLessThen90Days()
 				},
+				Phase: PhaseCertificates,
 			},
 			{
 				Fn:        saveKubeconfig,
 				Operation: "saving kubeconfig",
+				Phase:     PhaseKubeconfig,
 			},
 			{
 				Fn:        removeSuperKubeconfig,
 				Operation: "removing " + superAdminConfPath,
+				Phase:     PhaseKubeconfig,
 			},
 			{
 				Fn: func(s *state.State) error {
@@ -263,112 +356,162 @@ func WithResources(t Tasks) Tasks {
 			{
 				Fn:        features.Activate,
 				Operation: "activating features",
+				Phase:     PhaseFeatures,
 			},
 			{
 				Fn:        patchCoreDNS,
 				Operation: "patching CoreDNS",
+				Phase:     PhaseFeatures,
 			},
 			{
 				Fn:          credentials.Ensure,
 				Operation:   "ensuring credentials secret",
 				Description: "ensure credential",
+				Name:        "ensure-credentials",
 				Predicate:   func(s *state.State) bool { return s.Cluster.CloudProvider.SecretProviderClassName == "" },
+				Phase:       PhaseCredentials,
 			},
 			{
 				Fn:          ensureCABundleConfigMap,
 				Operation:   "ensuring caBundle configMap",
 				Description: "ensure caBundle configMap",
 				Predicate:   func(s *state.State) bool { return s.Cluster.CertificateAuthority.Bundle != "" },
+				DependsOn:   []string{"ensure-credentials"},
+				Phase:       PhaseCredentials,
 			},
 			{
 				Fn:          labelNodes,
 				Operation:   "labeling control-plane nodes",
 				Description: "labeling control-plane nodes",
+				Name:        "label-control-plane-nodes",
+				DependsOn:   []string{"ensure-credentials"},
+				Phase:       PhaseNodeLabels,
 			},
 			{
 				Fn:          annotateNodes,
 				Operation:   "annotating control-plane nodes",
 				Description: "annotating control-plane nodes",
+				DependsOn:   []string{"label-control-plane-nodes"},
+				Phase:       PhaseNodeLabels,
 			},
 			{
 				Fn:          cleanupStaleObjects,
 				Operation:   "cleaning up any leftovers from addons",
 				Description: "clean up any leftovers from addons",
+				Phase:       PhaseAddons,
 			},
 			{
 				Fn:          addons.Ensure,
 				Operation:   "applying addons",
 				Description: "ensure embedded addons",
+				Checkpoint:  true,
+				Name:        "apply-embedded-addons",
+				Phase:       PhaseAddons,
 			},
 			{
 				Fn:          addons.EnsureUserAddons,
 				Operation:   "applying addons",
 				Description: "ensure custom addons",
 				Predicate:   func(s *state.State) bool { return s.Cluster.Addons != nil },
+				Checkpoint:  true,
+				Name:        "apply-user-addons",
+				DependsOn:   []string{"apply-embedded-addons"},
+				Phase:       PhaseAddons,
 			},
 			{
-				Fn:        localhelm.Deploy,
-				Operation: "releasing core helm charts",
-			},
-			{
-				Fn:          externalccm.Ensure,
-				Operation:   "ensuring external CCM",
-				Description: "ensure external CCM",
-				Predicate:   func(s *state.State) bool { return s.Cluster.CloudProvider.External },
-			},
-			{
+				// the vSphere CSI namespace is created by the embedded CSI addon
 				Fn:          ensureVsphereCSICABundleConfigMap,
 				Operation:   "ensure vSphere CSI caBundle configMap",
 				Description: "ensure vSphere CSI caBundle configMap",
 				Predicate: func(s *state.State) bool {
 					return s.Cluster.CertificateAuthority.Bundle != "" && s.Cluster.CloudProvider.Vsphere != nil && s.Cluster.CloudProvider.External && !s.Cluster.CloudProvider.DisableBundledCSIDrivers
 				},
+				DependsOn: []string{"apply-embedded-addons"},
+				Phase:     PhaseCredentials,
 			},
 			{
-				Fn:        joinStaticWorkerNodes,
-				Operation: "joining static worker nodes to the cluster",
+				// the user addons may provide the CRDs and the namespaces
+				// the helm releases use
+				Fn:         localhelm.Deploy,
+				Operation:  "releasing core helm charts",
+				Checkpoint: true,
+				DependsOn:  []string{"apply-user-addons"},
+				Phase:      PhaseHelmReleases,
+			},
+			{
+				Fn:          externalccm.Ensure,
+				Operation:   "ensuring external CCM",
+				Description: "ensure external CCM",
+				Predicate:   func(s *state.State) bool { return s.Cluster.CloudProvider.External },
+				Checkpoint:  true,
+				Name:        "ensure-external-ccm",
+				Phase:       PhaseExternalCCM,
+			},
+			{
+				Fn:         joinStaticWorkerNodes,
+				Operation:  "joining static worker nodes to the cluster",
+				Checkpoint: true,
+				Name:       "join-static-workers",
+				Phase:      PhaseStaticWorkers,
 			},
 			{
 				Fn:          labelNodes,
 				Operation:   "labeling nodes",
 				Description: "labeling nodes",
+				Name:        "label-nodes",
+				DependsOn:   []string{"join-static-workers"},
+				Phase:       PhaseNodeLabels,
 			},
 			{
 				Fn:          annotateNodes,
 				Operation:   "annotating nodes",
 				Description: "annotating nodes",
+				DependsOn:   []string{"label-nodes"},
+				Phase:       PhaseNodeLabels,
 			},
 			{
 				Fn:        fixFilePermissions,
 				Operation: "Fix permissions of system files",
+				DependsOn: []string{"join-static-workers"},
+				Phase:     PhaseStaticWorkers,
 			},
 			{
 				Fn:        machinecontroller.WaitReady,
 				Operation: "waiting for machine-controller",
+				Name:      "wait-machine-controller",
+				DependsOn: []string{"join-static-workers"},
+				Phase:     PhaseMachineDeployments,
 			},
 			{
 				Fn:        operatingsystemmanager.WaitReady,
 				Operation: "waiting for operating-system-manager",
 				Predicate: func(s *state.State) bool { return s.Cluster.OperatingSystemManager.Deploy },
+				Name:      "wait-operating-system-manager",
+				DependsOn: []string{"join-static-workers"},
+				Phase:     PhaseMachineDeployments,
 			},
 			{
 				Fn:          upgradeMachineDeployments,
 				Operation:   "upgrading MachineDeployments",
 				Description: "upgrade MachineDeployments",
 				Predicate:   func(s *state.State) bool { return s.UpgradeMachineDeployments },
+				Checkpoint:  true,
+				DependsOn:   []string{"wait-machine-controller", "wait-operating-system-manager"},
+				Phase:       PhaseMachineDeployments,
 			},
 		}...,
 	)
 }
 
-func WithUpgrade(t Tasks, followers, staticWorkers []kubeoneapi.HostConfig) Tasks {
-	return WithHostnameOSAndProbes(t).
+func WithUpgrade(t Tasks, followers []kubeoneapi.HostConfig, staticWorkers kubeoneapi.StaticWorkersConfig) Tasks {
+	return withEtcdSnapshot(WithHostnameOSAndProbes(t)).
 		append(KubernetesConfigFiles()...). // this, in the upgrade process where config rails are handled
 		append(
 			Task{Fn: kubeconfig.BuildKubernetesClientset, Operation: "building kubernetes clientset"},
-			Task{Fn: uploadKubeadmToConfigMaps, Operation: "updating kubeadm configmaps"},
+			Task{Fn: recordPreUpgradeState, Operation: "recording pre-upgrade state", Checkpoint: true, Phase: PhaseControlPlane},
+			Task{Fn: uploadKubeadmToConfigMaps, Operation: "updating kubeadm configmaps", Phase: PhaseKubernetesConfig},
 			Task{Fn: runPreflightChecks, Operation: "checking preflight safetynet", Retries: 1},
-			Task{Fn: upgradeLeader, Operation: "upgrading leader control plane"},
+			Task{Fn: upgradeLeader, Operation: "upgrading leader control plane", Checkpoint: true, Phase: PhaseControlPlane},
 		).
 		append(generateUpgradeFollowersTasks(followers)...).
 		append(Task{
@@ -381,15 +524,16 @@ func WithUpgrade(t Tasks, followers, staticWorkers []kubeoneapi.HostConfig) Task
 		}).
 		append(WithResources(nil)...).
 		append(
-			Task{Fn: restartKubeAPIServer, Operation: "restarting unhealthy kube-apiserver"},
+			Task{Fn: restartKubeAPIServer, Operation: "restarting unhealthy kube-apiserver", Phase: PhaseControlPlane},
 		).
-		append(generateUpgradeStaticWorkersTasks(staticWorkers)...).
+		append(generateUpgradeStaticWorkersTasks(staticWorkers.Hosts, staticWorkers.UpgradeStrategy)...).
 		append(
-			Task{Fn: updateAllKubelets, Operation: "upgrading kubelets"},
+			Task{Fn: updateAllKubelets, Operation: "upgrading kubelets", Checkpoint: true, Phase: PhaseKubelets},
 			Task{
 				Fn:          migratePVCAllocatedResourceStatus,
 				Operation:   "migrating PVCs",
 				Description: "migrate PVCs with AllocatedResourceStatuses",
+				Checkpoint:  true,
 				Predicate: func(s *state.State) bool {
 					targetVersion, err := semver.NewVersion(s.Cluster.Versions.Kubernetes)
 					if err != nil {
@@ -407,17 +551,52 @@ func WithUpgrade(t Tasks, followers, staticWorkers []kubeoneapi.HostConfig) Task
 					// Run operation only when upgrading to Kubernetes 1.31.
 					return targetVersion.Minor() == 31 && liveCP[0].Kubelet.Version.Minor() == 30
 				},
+				Phase: PhaseKubelets,
 			},
 			Task{
 				Fn:          pruneImagesOnAllNodes,
 				Operation:   "deleting unused container images",
 				Description: "delete unused container images",
 				Predicate:   func(s *state.State) bool { return s.PruneImages },
+				Checkpoint:  true,
+				Phase:       PhaseCleanup,
 			},
 			Task{
 				Fn:          cleanupKubernetesTmp,
 				Operation:   "cleanup /etc/kubernetes/tmp",
 				Description: "delete temporary files from /etc/kubernetes/tmp",
+				Phase:       PhaseCleanup,
+			},
+		)
+}
+
+// WithRollback rolls back the upgrade using the state recorded before it,
+// node by node, restoring etcd from the pre-upgrade snapshot only if
+// requested
+func WithRollback(t Tasks) Tasks {
+	return WithProbes(WithHostnameOS(t)).
+		append(
+			Task{Fn: kubeconfig.BuildKubernetesClientset, Operation: "building kubernetes clientset"},
+			Task{
+				Fn:          restoreEtcdFromSnapshot,
+				Operation:   "restoring etcd",
+				Description: "restore etcd from the pre-upgrade snapshot",
+				Predicate:   func(s *state.State) bool { return s.RestoreEtcd },
+			},
+			Task{
+				Fn:          rollbackControlPlane,
+				Operation:   "rolling back control plane",
+				Description: "roll back control plane nodes",
+			},
+			Task{
+				Fn:          rollbackStaticWorkers,
+				Operation:   "rolling back static workers",
+				Description: "roll back static worker nodes",
+			},
+			Task{
+				Fn:          restoreUpgradeRecordConfigMaps,
+				Operation:   "restoring kubeadm configmaps",
+				Description: "restore kubeadm configmaps",
 			},
 		)
 }
@@ -445,8 +624,8 @@ func WithReset(t Tasks) Tasks {
 }
 
 func WithRemoveExtraEtcdMembers(t Tasks) Tasks {
-	return t.append(Tasks{
-		{Fn: repairClusterIfNeeded, Operation: "repairing cluster"},
+	return withEtcdSnapshot(t).append(Tasks{
+		{Fn: repairClusterIfNeeded, Operation: "repairing cluster", Phase: PhaseControlPlane},
 	}...)
 }
 
@@ -493,31 +672,34 @@ func WithClusterStatus(t Tasks) Tasks {
 
 func KubernetesConfigFiles() Tasks {
 	return Tasks{
-		{Fn: generateKubeadm, Description: "Generating kubeadm config files"},
-		{Fn: generateConfigurationFiles, Description: "Generating config files"},
-		{Fn: uploadConfigurationFiles, Description: "Uploading config files"},
+		{Fn: generateKubeadm, Description: "Generating kubeadm config files", Phase: PhaseKubernetesConfig},
+		{Fn: generateConfigurationFiles, Description: "Generating config files", Phase: PhaseKubernetesConfig},
+		{Fn: uploadConfigurationFiles, Description: "Uploading config files", Name: "upload-config-files", Phase: PhaseKubernetesConfig},
 	}
 }
 
 func WithDisableEncryptionProviders(t Tasks, customConfig bool) Tasks {
-	t = WithHostnameOSAndProbes(t)
+	t = withEtcdSnapshot(WithHostnameOSAndProbes(t))
 	if customConfig {
 		return t.append(Tasks{
 			{
 				Fn:          removeEncryptionProviderFile,
 				Operation:   "removing encryption providers configuration",
 				Description: "remove old Encryption Providers configuration file",
+				Phase:       PhaseEncryption,
 			},
 			{
 				Fn:          restartKubeAPIServer,
 				Operation:   "restarting KubeAPI",
 				Description: "restart KubeAPI containers",
+				Phase:       PhaseEncryption,
 			},
 
 			{
 				Fn:          rewriteClusterSecrets,
 				Operation:   "rewriting cluster secrets",
 				Description: "rewrite all cluster secrets",
+				Phase:       PhaseEncryption,
 			},
 		}...)
 	}
@@ -527,26 +709,31 @@ func WithDisableEncryptionProviders(t Tasks, customConfig bool) Tasks {
 			Fn:          fetchEncryptionProvidersFile,
 			Operation:   "fetching EncryptionProviders config",
 			Description: "fetch current Encryption Providers configuration file ",
+			Phase:       PhaseEncryption,
 		},
 		{
 			Fn:          uploadIdentityFirstEncryptionConfiguration,
 			Operation:   "uploading encryption providers configuration",
 			Description: "upload updated Encryption Providers configuration file",
+			Phase:       PhaseEncryption,
 		},
 		{
 			Fn:          restartKubeAPIServer,
 			Operation:   "restarting kube-apiserver pods",
 			Description: "restart KubeAPI containers",
+			Phase:       PhaseEncryption,
 		},
 		{
 			Fn:          rewriteClusterSecrets,
 			Operation:   "rewriting cluster secrets",
 			Description: "rewrite all cluster secrets",
+			Phase:       PhaseEncryption,
 		},
 		{
 			Fn:          removeEncryptionProviderFile,
 			Operation:   "removing encryption providers configuration",
 			Description: "remove old Encryption Providers configuration file",
+			Phase:       PhaseEncryption,
 		},
 	}...)
 }
@@ -557,6 +744,7 @@ func WithRewriteSecrets(t Tasks) Tasks {
 			Fn:          rewriteClusterSecrets,
 			Operation:   "rewriting cluster secrets",
 			Description: "rewrite all cluster secrets",
+			Phase:       PhaseEncryption,
 		},
 	)
 }
@@ -567,47 +755,55 @@ func WithCustomEncryptionConfigUpdated(t Tasks) Tasks {
 			Fn:          restartKubeAPIServer,
 			Operation:   "restarting KubeAPI",
 			Description: "restart KubeAPI containers",
+			Phase:       PhaseEncryption,
 		},
 		{
 			Fn:          rewriteClusterSecrets,
 			Operation:   "rewriting cluster secrets",
 			Description: "rewrite all cluster secrets",
+			Phase:       PhaseEncryption,
 		},
 	}...)
 }
 
 func WithRotateKey(t Tasks) Tasks {
-	return WithHostnameOSAndProbes(t).
+	return withEtcdSnapshot(WithHostnameOSAndProbes(t)).
 		append(Tasks{
 			{
 				Fn:          fetchEncryptionProvidersFile,
 				Operation:   "fetching EncryptionProviders config",
 				Description: "fetch current Encryption Providers configuration file ",
+				Phase:       PhaseEncryption,
 			},
 			{
 				Fn:          uploadEncryptionConfigurationWithNewKey,
 				Operation:   "uploading encryption providers configuration",
 				Description: "upload updated Encryption Providers configuration file",
+				Phase:       PhaseEncryption,
 			},
 			{
 				Fn:          restartKubeAPIServer,
 				Operation:   "restarting KubeAPI",
 				Description: "restart KubeAPI containers",
+				Phase:       PhaseEncryption,
 			},
 			{
 				Fn:          rewriteClusterSecrets,
 				Operation:   "rewriting cluster secrets",
 				Description: "rewrite all cluster secrets",
+				Phase:       PhaseEncryption,
 			},
 			{
 				Fn:          uploadEncryptionConfigurationWithoutOldKey,
 				Operation:   "uploading encryption providers configuration",
 				Description: "upload updated Encryption Providers configuration file",
+				Phase:       PhaseEncryption,
 			},
 			{
 				Fn:          restartKubeAPIServer,
 				Operation:   "restarting kube-apiserver pods",
 				Description: "restart KubeAPI containers",
+				Phase:       PhaseEncryption,
 			},
 		}...)
 }
@@ -621,10 +817,6 @@ func updateAllKubelets(s *state.State) error {
 			return err
 		}
 
-		sleep := 30 * time.Second
-		s.Logger.Infof("Sleeping %s seconds, giving time for kubeapi server to restart", sleep)
-		time.Sleep(sleep)
-
-		return nil
+		return waitForNodeHealthy(s, node, true)
 	}, state.RunSequentially)
 }
@@ -18,7 +18,6 @@ package tasks
 
 import (
 	"fmt"
-	"time"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/executor"
@@ -33,10 +32,12 @@ func generateUpgradeFollowersTasks(followers []kubeoneapi.HostConfig) []Task {
 	for _, follower := range followers {
 		upgradeFollowersTasks = append(upgradeFollowersTasks, Task{
 			Fn: func(s *state.State) error {
-				return s.RunTaskOnNodes([]kubeoneapi.HostConfig{follower}, upgradeFollowerExecutor, state.RunSequentially, nil)
+				return s.RunTaskOnNodes([]kubeoneapi.HostConfig{follower}, state.Critical(upgradeFollowerExecutor), state.RunSequentially, nil)
 			},
 			Description: fmt.Sprintf("upgrading %s follower control plane", follower.PrivateAddress),
 			Operation:   "upgrading follower control plane",
+			Checkpoint:  true,
+			Phase:       PhaseControlPlane,
 		})
 	}
 
@@ -53,6 +54,10 @@ func upgradeFollowerExecutor(s *state.State, node *kubeoneapi.HostConfig, conn e
 
 	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
 
+	if err := runHooks(s, s.Cluster.Hooks.BeforeDrain, hookBeforeDrain, node); err != nil {
+		return err
+	}
+
 	logger.Infoln("Cordon the follower control plane node...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
 		return err
@@ -86,13 +91,18 @@ func upgradeFollowerExecutor(s *state.State, node *kubeoneapi.HostConfig, conn e
 		return err
 	}
 
-	logger.Infof("Waiting %v to ensure all components are up...", timeoutNodeUpgrade)
-	time.Sleep(timeoutNodeUpgrade)
+	if err := waitForNodeHealthy(s, node, false); err != nil {
+		return err
+	}
 
 	logger.Infoln("Unlabeling follower control plane...")
 	if err := unlabelNode(s.DynamicClient, node); err != nil {
 		return err
 	}
 
-	return ApprovePendingCSR(s, node, conn)
+	if err := ApprovePendingCSR(s, node, conn); err != nil {
+		return err
+	}
+
+	return runHooks(s, s.Cluster.Hooks.AfterUpgrade, hookAfterUpgrade, node)
 }
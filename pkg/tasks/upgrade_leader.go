@@ -17,8 +17,6 @@ limitations under the License.
 package tasks
 
 import (
-	"time"
-
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/nodeutils"
@@ -27,7 +25,7 @@ import (
 )
 
 func upgradeLeader(s *state.State) error {
-	return s.RunTaskOnLeader(upgradeLeaderExecutor)
+	return s.RunTaskOnLeader(state.Critical(upgradeLeaderExecutor))
 }
 
 func upgradeLeaderExecutor(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
@@ -40,6 +38,10 @@ func upgradeLeaderExecutor(s *state.State, node *kubeoneapi.HostConfig, conn exe
 
 	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
 
+	if err := runHooks(s, s.Cluster.Hooks.BeforeDrain, hookBeforeDrain, node); err != nil {
+		return err
+	}
+
 	logger.Infoln("Cordoning leader control plane...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
 		return err
@@ -73,13 +75,18 @@ func upgradeLeaderExecutor(s *state.State, node *kubeoneapi.HostConfig, conn exe
 		return err
 	}
 
-	logger.Infof("Waiting %v to ensure all components are up...", timeoutNodeUpgrade)
-	time.Sleep(timeoutNodeUpgrade)
+	if err := waitForNodeHealthy(s, node, false); err != nil {
+		return err
+	}
 
 	logger.Infoln("Unlabeling leader control plane...")
 	if err := unlabelNode(s.DynamicClient, node); err != nil {
 		return err
 	}
 
-	return ApprovePendingCSR(s, node, conn)
+	if err := ApprovePendingCSR(s, node, conn); err != nil {
+		return err
+	}
+
+	return runHooks(s, s.Cluster.Hooks.AfterUpgrade, hookAfterUpgrade, node)
 }
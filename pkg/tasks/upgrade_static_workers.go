@@ -18,32 +18,113 @@ package tasks
 
 import (
 	"fmt"
-	"time"
+	"strings"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/nodeutils"
 	"k8c.io/kubeone/pkg/scripts"
 	"k8c.io/kubeone/pkg/state"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func generateUpgradeStaticWorkersTasks(staticWorkers []kubeoneapi.HostConfig) []Task {
+func generateUpgradeStaticWorkersTasks(staticWorkers []kubeoneapi.HostConfig, strategy kubeoneapi.StaticWorkersUpgradeStrategy) []Task {
 	var upgradeStaticWorkersTasks []Task
 
-	// we upgrade seqentially to minimize cluster disruption
-	for _, staticWorker := range staticWorkers {
+	// batches are upgraded one after another to limit the cluster disruption
+	for _, batch := range staticWorkersUpgradeBatches(staticWorkers, strategy) {
+		addresses := make([]string, 0, len(batch))
+		for _, staticWorker := range batch {
+			addresses = append(addresses, staticWorker.PrivateAddress)
+		}
+
+		description := fmt.Sprintf("upgrading %s static worker node", addresses[0])
+		if len(batch) > 1 {
+			description = fmt.Sprintf("upgrading %s static worker nodes", strings.Join(addresses, ", "))
+		}
+
 		upgradeStaticWorkersTasks = append(upgradeStaticWorkersTasks, Task{
 			Fn: func(s *state.State) error {
-				return s.RunTaskOnNodes([]kubeoneapi.HostConfig{staticWorker}, upgradeStaticWorkersExecutor, state.RunSequentially, nil)
+				return s.RunTaskOnNodes(batch, state.Critical(upgradeStaticWorkersExecutor), state.RunParallel, nil)
 			},
-			Description: fmt.Sprintf("upgrading %s static worker node", staticWorker.PrivateAddress),
+			Description: description,
 			Operation:   "upgrading static worker nodes",
+			Checkpoint:  true,
+			Phase:       PhaseStaticWorkers,
 		})
 	}
 
 	return upgradeStaticWorkersTasks
 }
 
+// staticWorkersUpgradeBatches splits the static workers into batches of nodes
+// upgraded at the same time. Each group of nodes sharing the GroupByLabel value
+// is split into batches of at most MaxUnavailable nodes, after the canary node
+// is upgraded alone.
+func staticWorkersUpgradeBatches(staticWorkers []kubeoneapi.HostConfig, strategy kubeoneapi.StaticWorkersUpgradeStrategy) [][]kubeoneapi.HostConfig {
+	var batches [][]kubeoneapi.HostConfig
+
+	for idx, group := range groupHostsByLabel(staticWorkers, strategy.GroupByLabel) {
+		batchSize := 1
+		if strategy.MaxUnavailable != nil {
+			// the value has been validated already
+			maxUnavailable, _ := intstr.GetScaledValueFromIntOrPercent(strategy.MaxUnavailable, len(group), false)
+			batchSize = max(maxUnavailable, 1)
+		}
+
+		if idx == 0 && strategy.Canary {
+			batches = append(batches, group[:1])
+			group = group[1:]
+		}
+
+		for len(group) > 0 {
+			size := min(batchSize, len(group))
+			batches = append(batches, group[:size])
+			group = group[size:]
+		}
+	}
+
+	return batches
+}
+
+// groupHostsByLabel groups the hosts by the value of the given label, in the
+// order of the first host of each group. Hosts without the label form their
+// own group.
+func groupHostsByLabel(hosts []kubeoneapi.HostConfig, label string) [][]kubeoneapi.HostConfig {
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	if label == "" {
+		return [][]kubeoneapi.HostConfig{hosts}
+	}
+
+	type groupKey struct {
+		value   string
+		labeled bool
+	}
+
+	var groups [][]kubeoneapi.HostConfig
+	index := map[groupKey]int{}
+
+	for _, host := range hosts {
+		value, labeled := host.Labels[label]
+		key := groupKey{value: value, labeled: labeled}
+
+		idx, found := index[key]
+		if !found {
+			idx = len(groups)
+			index[key] = idx
+			groups = append(groups, nil)
+		}
+
+		groups[idx] = append(groups[idx], host)
+	}
+
+	return groups
+}
+
 func upgradeStaticWorkersExecutor(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
 	logger := s.Logger.WithField("node", node.PublicAddress)
 
@@ -55,6 +136,10 @@ func upgradeStaticWorkersExecutor(s *state.State, node *kubeoneapi.HostConfig, c
 
 	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
 
+	if err := runHooks(s, s.Cluster.Hooks.BeforeDrain, hookBeforeDrain, node); err != nil {
+		return err
+	}
+
 	logger.Infoln("Cordoning static worker node...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
 		return err
@@ -88,13 +173,18 @@ func upgradeStaticWorkersExecutor(s *state.State, node *kubeoneapi.HostConfig, c
 		return err
 	}
 
-	logger.Infof("Waiting %v to ensure all components are up...", timeoutNodeUpgrade)
-	time.Sleep(timeoutNodeUpgrade)
+	if err := waitForNodeHealthy(s, node, false); err != nil {
+		return err
+	}
 
 	logger.Infoln("Unlabeling static worker node...")
 	if err := unlabelNode(s.DynamicClient, node); err != nil {
 		return err
 	}
 
-	return ApprovePendingCSR(s, node, conn)
+	if err := ApprovePendingCSR(s, node, conn); err != nil {
+		return err
+	}
+
+	return runHooks(s, s.Cluster.Hooks.AfterUpgrade, hookAfterUpgrade, node)
 }
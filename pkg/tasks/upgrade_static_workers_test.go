@@ -19,34 +19,21 @@ package tasks
 import (
 	"errors"
 	"io"
+	"reflect"
 	"testing"
 
 	"github.com/sirupsen/logrus"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
-	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/executor/executortest"
 	"k8c.io/kubeone/pkg/state"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 var errRefusedByStub = errors.New("refused by stub executor")
 
-// hostRecordingExecutor records the hosts it was asked to connect to and then
-// refuses the connection, which ends the task after it selected a node but
-// before it touches one.
-type hostRecordingExecutor struct {
-	openedHosts []string
-}
-
-func (e *hostRecordingExecutor) Open(host kubeoneapi.HostConfig) (executor.Interface, error) {
-	e.openedHosts = append(e.openedHosts, host.PrivateAddress)
-
-	return nil, errRefusedByStub
-}
-
-func (e *hostRecordingExecutor) Tunnel(_ kubeoneapi.HostConfig) (executor.Tunneler, error) {
-	return nil, errRefusedByStub
-}
-
 func Test_generateUpgradeStaticWorkersTasks(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -82,7 +69,7 @@ func Test_generateUpgradeStaticWorkersTasks(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := generateUpgradeStaticWorkersTasks(tt.staticWorkers)
+			got := generateUpgradeStaticWorkersTasks(tt.staticWorkers, kubeoneapi.StaticWorkersUpgradeStrategy{})
 
 			if len(got) != len(tt.wantDescriptions) {
 				t.Fatalf(
@@ -126,7 +113,7 @@ func Test_generateUpgradeStaticWorkersTasks_runsOnMatchingNode(t *testing.T) {
 		{PrivateAddress: "192.168.1.3"},
 	}
 
-	got := generateUpgradeStaticWorkersTasks(staticWorkers)
+	got := generateUpgradeStaticWorkersTasks(staticWorkers, kubeoneapi.StaticWorkersUpgradeStrategy{})
 
 	if len(got) != len(staticWorkers) {
 		t.Fatalf(
@@ -137,7 +124,10 @@ func Test_generateUpgradeStaticWorkersTasks_runsOnMatchingNode(t *testing.T) {
 	}
 
 	for idx, task := range got {
-		stubExecutor := &hostRecordingExecutor{}
+		// The stub executor records the hosts it was asked to connect to and
+		// then refuses the connection, which ends the task after it selected
+		// a node but before it touches one.
+		stubExecutor := &executortest.Fake{OpenErr: errRefusedByStub}
 
 		logger := logrus.New()
 		logger.SetOutput(io.Discard)
@@ -152,21 +142,116 @@ func Test_generateUpgradeStaticWorkersTasks_runsOnMatchingNode(t *testing.T) {
 			t.Fatalf("task %d error = %v, want %v", idx, err, errRefusedByStub)
 		}
 
-		if len(stubExecutor.openedHosts) != 1 {
+		openedHosts := stubExecutor.Opened()
+		if len(openedHosts) != 1 {
 			t.Fatalf(
 				"task %d connected to %d hosts, want 1",
 				idx,
-				len(stubExecutor.openedHosts),
+				len(openedHosts),
 			)
 		}
 
-		if stubExecutor.openedHosts[0] != staticWorkers[idx].PrivateAddress {
+		if openedHosts[0] != staticWorkers[idx].PrivateAddress {
 			t.Errorf(
 				"task %d connected to host %q, want %q",
 				idx,
-				stubExecutor.openedHosts[0],
+				openedHosts[0],
 				staticWorkers[idx].PrivateAddress,
 			)
 		}
 	}
 }
+
+func Test_staticWorkersUpgradeBatches(t *testing.T) {
+	host := func(address, zone string) kubeoneapi.HostConfig {
+		h := kubeoneapi.HostConfig{PrivateAddress: address}
+		if zone != "" {
+			h.Labels = map[string]string{"zone": zone}
+		}
+
+		return h
+	}
+
+	hosts := []kubeoneapi.HostConfig{
+		host("10.0.0.1", "a"),
+		host("10.0.0.2", "b"),
+		host("10.0.0.3", "a"),
+		host("10.0.0.4", ""),
+		host("10.0.0.5", "b"),
+		host("10.0.0.6", "a"),
+	}
+
+	tests := []struct {
+		name     string
+		strategy kubeoneapi.StaticWorkersUpgradeStrategy
+		want     [][]string
+	}{
+		{
+			name: "one node at a time by default",
+			want: [][]string{{"10.0.0.1"}, {"10.0.0.2"}, {"10.0.0.3"}, {"10.0.0.4"}, {"10.0.0.5"}, {"10.0.0.6"}},
+		},
+		{
+			name: "batches of maxUnavailable nodes",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromInt32(4)),
+			},
+			want: [][]string{{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}, {"10.0.0.5", "10.0.0.6"}},
+		},
+		{
+			name: "percentage is rounded down",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromString("50%")),
+			},
+			want: [][]string{{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, {"10.0.0.4", "10.0.0.5", "10.0.0.6"}},
+		},
+		{
+			name: "at least one node is upgraded at a time",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromString("10%")),
+			},
+			want: [][]string{{"10.0.0.1"}, {"10.0.0.2"}, {"10.0.0.3"}, {"10.0.0.4"}, {"10.0.0.5"}, {"10.0.0.6"}},
+		},
+		{
+			name: "canary first",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromInt32(3)),
+				Canary:         true,
+			},
+			want: [][]string{{"10.0.0.1"}, {"10.0.0.2", "10.0.0.3", "10.0.0.4"}, {"10.0.0.5", "10.0.0.6"}},
+		},
+		{
+			name: "grouped by label",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromString("100%")),
+				GroupByLabel:   "zone",
+			},
+			want: [][]string{{"10.0.0.1", "10.0.0.3", "10.0.0.6"}, {"10.0.0.2", "10.0.0.5"}, {"10.0.0.4"}},
+		},
+		{
+			name: "grouped by label with canary",
+			strategy: kubeoneapi.StaticWorkersUpgradeStrategy{
+				MaxUnavailable: ptr.To(intstr.FromInt32(2)),
+				Canary:         true,
+				GroupByLabel:   "zone",
+			},
+			want: [][]string{{"10.0.0.1"}, {"10.0.0.3", "10.0.0.6"}, {"10.0.0.2", "10.0.0.5"}, {"10.0.0.4"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]string
+			for _, batch := range staticWorkersUpgradeBatches(hosts, tt.strategy) {
+				var addresses []string
+				for _, h := range batch {
+					addresses = append(addresses, h.PrivateAddress)
+				}
+				got = append(got, addresses)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("staticWorkersUpgradeBatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
-	"time"
 
 	osrelease "github.com/dominodatalab/os-release"
 	"github.com/pkg/errors"
@@ -42,9 +41,6 @@ import (
 const (
 	labelUpgradeLock      = "kubeone.io/upgrade-in-progress"
 	labelControlPlaneNode = "node-role.kubernetes.io/control-plane"
-	// timeoutNodeUpgrade is time for how long kubeone will wait after finishing the upgrade
-	// process on the node
-	timeoutNodeUpgrade = 30 * time.Second
 )
 
 func determineHostname(s *state.State) error {
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing instruments KubeOne runs with OpenTelemetry spans. Unless
+// Setup is called, spans are not recorded.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const instrumentationName = "k8c.io/kubeone"
+
+// Attribute keys used by the KubeOne spans
+const (
+	TaskKey    = attribute.Key("kubeone.task")
+	NodeKey    = attribute.Key("kubeone.node")
+	AttemptKey = attribute.Key("kubeone.attempt")
+	CommandKey = attribute.Key("kubeone.command")
+)
+
+var (
+	lock     sync.Mutex
+	provider *sdktrace.TracerProvider
+	rootSpan trace.Span
+)
+
+// Options selects where the spans are exported to
+type Options struct {
+	// OTLPEndpoint is the URL of the OTLP/HTTP collector, e.g.
+	// http://localhost:4318
+	OTLPEndpoint string
+	// File is the path of the file the spans are written to as JSON
+	File string
+}
+
+// Setup installs the tracer provider exporting to the configured
+// destinations and starts the root span of the run, see WithRootSpan.
+// Shutdown must be called to flush the spans.
+func Setup(ctx context.Context, name string, opts Options) error {
+	if opts.OTLPEndpoint == "" && opts.File == "" {
+		return nil
+	}
+
+	var providerOpts []sdktrace.TracerProviderOption
+
+	if opts.OTLPEndpoint != "" {
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(opts.OTLPEndpoint))
+		if err != nil {
+			return fail.Config(err, "creating OTLP trace exporter")
+		}
+
+		providerOpts = append(providerOpts, sdktrace.WithBatcher(exporter))
+	}
+
+	if opts.File != "" {
+		f, err := os.Create(opts.File)
+		if err != nil {
+			return fail.Runtime(err, "creating trace file")
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			return fail.Runtime(err, "creating file trace exporter")
+		}
+
+		providerOpts = append(providerOpts, sdktrace.WithBatcher(exporter))
+	}
+
+	res := sdkresource.NewSchemaless(semconv.ServiceName("kubeone"))
+	providerOpts = append(providerOpts, sdktrace.WithResource(res))
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	provider = sdktrace.NewTracerProvider(providerOpts...)
+	otel.SetTracerProvider(provider)
+
+	_, rootSpan = Start(ctx, name)
+
+	return nil
+}
+
+// WithRootSpan returns the context carrying the root span of the run, if the
+// tracing has been set up
+func WithRootSpan(ctx context.Context) context.Context {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if rootSpan == nil {
+		return ctx
+	}
+
+	return trace.ContextWithSpan(ctx, rootSpan)
+}
+
+// Shutdown ends the root span and flushes all spans to the exporters
+func Shutdown(ctx context.Context, err error) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if provider == nil {
+		return nil
+	}
+
+	End(rootSpan, err)
+
+	return errors.Join(provider.ForceFlush(ctx), provider.Shutdown(ctx))
+}
+
+// Start starts the span as a child of the span in the context, if any
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error, if any, and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
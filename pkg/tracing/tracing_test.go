@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileExporter(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "trace.json")
+
+	if err := Setup(context.Background(), "kubeone apply", Options{File: filename}); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	ctx, task := Start(WithRootSpan(context.Background()), "install", TaskKey.String("install"))
+	_, node := Start(ctx, "node 192.168.1.1", NodeKey.String("192.168.1.1"))
+	End(node, errors.New("boom"))
+	End(task, nil)
+
+	if err := Shutdown(context.Background(), nil); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	buf, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("reading trace file: %v", err)
+	}
+
+	type span struct {
+		Name   string
+		Parent struct {
+			SpanID string
+		}
+		SpanContext struct {
+			SpanID string
+		}
+		Status struct {
+			Code string
+		}
+	}
+
+	spans := map[string]span{}
+	dec := json.NewDecoder(strings.NewReader(string(buf)))
+	for dec.More() {
+		var sp span
+		if err = dec.Decode(&sp); err != nil {
+			t.Fatalf("decoding span: %v", err)
+		}
+		spans[sp.Name] = sp
+	}
+
+	for _, name := range []string{"kubeone apply", "install", "node 192.168.1.1"} {
+		if _, ok := spans[name]; !ok {
+			t.Fatalf("span %q not exported, got %v", name, spans)
+		}
+	}
+
+	if spans["install"].Parent.SpanID != spans["kubeone apply"].SpanContext.SpanID {
+		t.Errorf("task span is not a child of the root span")
+	}
+
+	if spans["node 192.168.1.1"].Parent.SpanID != spans["install"].SpanContext.SpanID {
+		t.Errorf("node span is not a child of the task span")
+	}
+
+	if got := spans["node 192.168.1.1"].Status.Code; got != "Error" {
+		t.Errorf("node span status = %q, want Error", got)
+	}
+}